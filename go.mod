@@ -2,4 +2,4 @@ module github.com/brmatvey/go-graphs
 
 go 1.20
 
-require github.com/brmatvey/go-data-structs v0.0.0-20230430103903-e6cd469a7933 // indirect
+require github.com/brmatvey/go-data-structs v0.0.0-20230430103903-e6cd469a7933
@@ -0,0 +1,51 @@
+package graph
+
+// Attributes keep arbitrary metadata of node or edge: labels, capacities, costs, timestamps etc.
+type Attributes map[string]any
+
+func (a Attributes) Clone() Attributes {
+	res := make(Attributes, len(a))
+	for name, value := range a {
+		res[name] = value
+	}
+	return res
+}
+
+// Number converts numeric attribute to weight type.
+func Number[W Weight](a Attributes, name string) (W, bool) {
+	switch v := a[name].(type) {
+	case W:
+		return v, true
+	case int:
+		return W(v), true
+	case int8:
+		return W(v), true
+	case int16:
+		return W(v), true
+	case int32:
+		return W(v), true
+	case int64:
+		return W(v), true
+	case uint:
+		return W(v), true
+	case uint8:
+		return W(v), true
+	case uint16:
+		return W(v), true
+	case uint32:
+		return W(v), true
+	case uint64:
+		return W(v), true
+	case float32:
+		return W(v), true
+	case float64:
+		return W(v), true
+	}
+	return 0, false
+}
+
+func copyAttributes(dst, src Attributes) {
+	for name, value := range src {
+		dst[name] = value
+	}
+}
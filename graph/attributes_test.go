@@ -0,0 +1,113 @@
+package graph_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestAttributes(t *testing.T) {
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 1).WithAttributes(graph.Attributes{"label": "road", "cost": 5})},
+		2: {},
+	}
+	nodeAttributes := map[int]graph.Attributes{1: {"name": "first"}}
+	keyGen := func() int { return 1 }
+
+	t.Run("creator", func(t *testing.T) {
+		weightedGraph, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, keyGen).WithNodeAttributes(nodeAttributes))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		n1, _ := weightedGraph.Node(1)
+		n2, _ := weightedGraph.Node(2)
+		if n1.Attributes()["name"] != "first" || n2.Attributes() == nil || len(n2.Attributes()) != 0 {
+			t.Fatal("incorrect node attributes")
+		}
+		e, _ := weightedGraph.Edge(1)
+		if e.Attributes()["label"] != "road" {
+			t.Fatal("incorrect edge attributes")
+		}
+		if cost, ok := graph.Number[float64](e.Attributes(), "cost"); !ok || cost != 5 {
+			t.Fatal("cost must be converted to weight type")
+		}
+		if _, ok := graph.Number[float64](e.Attributes(), "label"); ok {
+			t.Fatal("label is not a number")
+		}
+		n1.Attributes()["name"] = "changed"
+		if nodeAttributes[1]["name"] != "first" {
+			t.Fatal("creator attributes must be copied")
+		}
+	})
+
+	t.Run("undirected weighted graph", func(t *testing.T) {
+		undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(dependencies, keyGen))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		e, _ := undirectedGraph.FindEdge(2, 1)
+		if e.Attributes()["label"] != "road" {
+			t.Fatal("reversed edge must share attributes")
+		}
+	})
+
+	t.Run("clone and operations", func(t *testing.T) {
+		weightedGraph, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, keyGen).WithNodeAttributes(nodeAttributes))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		cloned, err := graph.CloneWeighted(weightedGraph)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		n1, _ := cloned.Node(1)
+		e, _ := cloned.Edge(1)
+		if n1.Attributes()["name"] != "first" || e.Attributes()["label"] != "road" {
+			t.Fatal("attributes must be preserved")
+		}
+		e.Attributes()["label"] = "changed"
+		if original, _ := weightedGraph.Edge(1); original.Attributes()["label"] != "road" {
+			t.Fatal("original attributes must not be changed")
+		}
+
+		transposed, err := graph.TransposeWeighted(weightedGraph)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if e, _ = transposed.Edge(1); e.Attributes()["label"] != "road" {
+			t.Fatal("attributes must be preserved")
+		}
+
+		union, err := graph.UnionWeighted(weightedGraph, cloned)
+		if err != nil {
+			t.Fatal("edges with different attributes must not conflict")
+		}
+		if e, _ = union.Edge(1); e.Attributes()["label"] != "road" {
+			t.Fatal("attributes of left graph must win")
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		weightedGraph, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, keyGen).WithNodeAttributes(nodeAttributes))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		n1, _ := weightedGraph.Node(1)
+		data, err := json.Marshal(n1)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if string(data) != `{"key":1,"attributes":{"name":"first"},"children":[2]}` {
+			t.Fatalf("incorrect json of node %s", data)
+		}
+		e, _ := weightedGraph.Edge(1)
+		data, err = json.Marshal(e)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if string(data) != `{"key":1,"weight":1,"attributes":{"cost":5,"label":"road"},"from":1,"to":2}` {
+			t.Fatalf("incorrect json of edge %s", data)
+		}
+	})
+}
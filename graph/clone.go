@@ -0,0 +1,67 @@
+package graph
+
+// Clones keep kind of graph: undirected graphs and multigraphs are rebuilt by their own constructors.
+
+func Clone[T comparable](g DirectedGraph[T]) (DirectedGraph[T], error) {
+	return buildDirectedGraph(nodeKeys(g), nodeAttributes(g), graphLinks(g), isUndirected(g))
+}
+
+func CloneMap[T, U comparable](g DirectedGraph[T], f func(T) U) (DirectedGraph[U], error) {
+	keys, err := mapKeys(g, f)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]path[U], 0)
+	for _, l := range graphLinks(g) {
+		links = append(links, newPath(keys[l.from], keys[l.to]))
+	}
+	return buildDirectedGraph(mapValues(keys), mapAttributes(g, keys), links, isUndirected(g))
+}
+
+func CloneWeighted[K, T comparable, W Weight](g WeightedGraph[K, T, W]) (WeightedGraph[K, T, W], error) {
+	edges := make([]edgeSettings[K, T, W], 0)
+	for _, e := range g.Edges() {
+		edges = append(edges, toEdgeSettings(e))
+	}
+	return buildWeightedGraph(nodeKeys[T](g), nodeAttributes[T](g), edges, isMultigraph(g), isUndirected[T](g))
+}
+
+func CloneMapWeighted[K, T, U comparable, W Weight](g WeightedGraph[K, T, W], f func(T) U) (WeightedGraph[K, U, W], error) {
+	keys, err := mapKeys[T](g, f)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]edgeSettings[K, U, W], 0)
+	for _, e := range g.Edges() {
+		edges = append(edges, edgeSettings[K, U, W]{key: e.Key(), weight: e.Weight(), attributes: e.Attributes(), from: keys[e.From().Key()], to: keys[e.To().Key()]})
+	}
+	return buildWeightedGraph(mapValues(keys), mapAttributes[T](g, keys), edges, isMultigraph(g), isUndirected[T](g))
+}
+
+func mapKeys[T, U comparable](g DirectedGraph[T], f func(T) U) (map[T]U, error) {
+	keys, mapped := make(map[T]U), make(map[U]T)
+	for _, n := range g.Nodes() {
+		key := f(n.Key())
+		if _, ok := mapped[key]; ok {
+			return nil, &DuplicateKeyError{Kind: KindNode, Key: key}
+		}
+		keys[n.Key()], mapped[key] = key, n.Key()
+	}
+	return keys, nil
+}
+
+func mapAttributes[T, U comparable](g DirectedGraph[T], keys map[T]U) map[U]Attributes {
+	attributes := make(map[U]Attributes, len(keys))
+	for _, n := range g.Nodes() {
+		attributes[keys[n.Key()]] = n.Attributes()
+	}
+	return attributes
+}
+
+func mapValues[T, U comparable](m map[T]U) []U {
+	values := make([]U, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
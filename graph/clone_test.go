@@ -0,0 +1,157 @@
+package graph_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestClone(t *testing.T) {
+	t.Run("directed graph", func(t *testing.T) {
+		directedGraph, err := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(map[int][]int{1: {2}, 2: {3}}))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		cloned, err := graph.Clone(directedGraph)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, cloned, map[int][]int{1: {2}, 2: {3}, 3: {}})
+
+		original, _ := directedGraph.Node(1)
+		copied, _ := cloned.Node(1)
+		if original == copied {
+			t.Fatal("nodes must be fresh")
+		}
+		copied.AddChildren(copied)
+		if len(original.Children()) != 1 {
+			t.Fatal("original graph must not be changed")
+		}
+	})
+
+	t.Run("directed graph with mapped keys", func(t *testing.T) {
+		directedGraph, err := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(map[int][]int{1: {2}, 2: {}}))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		cloned, err := graph.CloneMap(directedGraph, strconv.Itoa)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		n1, ok := cloned.Node("1")
+		if !ok || len(n1.Children()) != 1 || n1.Children()[0].Key() != "2" {
+			t.Fatal("links must be preserved")
+		}
+
+		if _, err = graph.CloneMap(directedGraph, func(int) string { return "same" }); err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+
+	t.Run("weighted graph", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		e := graph.NewEdge(1, 2.0, n1, n2)
+		weightedGraph, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		cloned, err := graph.CloneWeighted(weightedGraph)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		copied, ok := cloned.Edge(1)
+		if !ok || copied == e || copied.Weight() != 2.0 || copied.From() == n1 || copied.From().Key() != 1 {
+			t.Fatal("edge must be fresh copy")
+		}
+
+		mapped, err := graph.CloneMapWeighted(weightedGraph, func(key int) int { return key * 10 })
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if e, ok := mapped.FindEdge(10, 20); !ok || e.Key() != 1 || e.Weight() != 2.0 {
+			t.Fatal("edge must be rekeyed")
+		}
+	})
+
+	t.Run("undirected graph", func(t *testing.T) {
+		undirectedGraph, err := graph.NewUndirectedGraphFromCreator(graph.NewUndirectedGraphCreator(map[int][]int{1: {2}, 2: {3}}))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		cloned, err := graph.Clone[int](undirectedGraph)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if _, ok := cloned.(graph.UndirectedGraph[int]); !ok {
+			t.Fatal("clone must be undirected")
+		}
+		checkLinks(t, cloned, map[int][]int{1: {2}, 2: {1, 3}, 3: {2}})
+	})
+
+	t.Run("undirected weighted graph", func(t *testing.T) {
+		count := 0
+		edgeKeyGen := func() int {
+			count++
+			return count
+		}
+		dependencies := map[int][]graph.Length[int, float64]{1: {graph.NewLength(2, 2.0)}, 2: {}}
+		undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(dependencies, edgeKeyGen))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		for _, clone := range []func() (graph.WeightedGraph[int, int, float64], error){
+			func() (graph.WeightedGraph[int, int, float64], error) {
+				return graph.CloneWeighted[int, int, float64](undirectedGraph)
+			},
+			func() (graph.WeightedGraph[int, int, float64], error) {
+				return graph.CloneMapWeighted[int, int, int, float64](undirectedGraph, func(key int) int { return key })
+			},
+		} {
+			cloned, err := clone()
+			if err != nil {
+				t.Fatal("err must be nil")
+			}
+			if _, ok := cloned.(graph.UndirectedWeightedGraph[int, int, float64]); !ok {
+				t.Fatal("clone must be undirected")
+			}
+			if e, ok := cloned.FindEdge(2, 1); !ok || e.Weight() != 2.0 || len(cloned.Edges()) != 1 {
+				t.Fatal("edge must be kept in both directions")
+			}
+		}
+	})
+
+	t.Run("multigraph", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		multigraph, err := graph.NewWeightedMultigraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{graph.NewEdge(1, 2.0, n1, n2)})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		cloned, err := graph.CloneWeighted(multigraph)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		m1, m2 := graph.NewNode(1), graph.NewNode(2)
+		m1.AddChildren(m2)
+		parallel, err := graph.NewWeightedGraph([]graph.Node[int]{m1, m2}, []graph.Edge[int, int, float64]{graph.NewEdge(2, 3.0, m1, m2)})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		// clone of multigraph without parallel edges is still multigraph
+		union, err := graph.UnionWeighted(cloned, parallel)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if len(union.FindEdges(1, 2)) != 2 {
+			t.Fatal("parallel edges must be kept")
+		}
+	})
+}
@@ -1,10 +1,11 @@
 package graph
 
-import "errors"
-
 type DirectedGraph[T comparable] interface {
 	Node(T) (Node[T], bool)
 	Nodes() []Node[T]
+	Parents(T) []Node[T]
+	InDegree(T) int
+	OutDegree(T) int
 }
 
 func NewDirectedGraphFromCreator[T comparable](creator DirectedGraphCreator[T]) (DirectedGraph[T], error) {
@@ -12,7 +13,7 @@ func NewDirectedGraphFromCreator[T comparable](creator DirectedGraphCreator[T])
 	nodesMap := make(map[T]Node[T])
 	getNode := func(key T) Node[T] {
 		if _, ok := nodesMap[key]; !ok {
-			nodesMap[key] = NewNode(key)
+			nodesMap[key] = NewNodeWithAttributes(key, creator.attributes[key])
 		}
 		return nodesMap[key]
 	}
@@ -47,20 +48,23 @@ func NewDirectedGraph[T comparable](ns ...Node[T]) (DirectedGraph[T], error) {
 		}
 	}
 
-	nodes := make([]Node[T], 0, len(nodesMap))
+	parentsMap := make(map[T][]Node[T])
 	for _, n := range nodesMap {
-		nodes = append(nodes, n)
+		for _, child := range n.Children() {
+			parentsMap[child.Key()] = append(parentsMap[child.Key()], n)
+		}
 	}
 
 	return &directedGraph[T]{
-		nodesMap: nodesMap,
+		nodesMap:   nodesMap,
+		parentsMap: parentsMap,
 	}, nil
 }
 
 func bfs[T comparable](set map[T]Node[T], node Node[T], f func(node Node[T]) error) error {
 	if foundNode, ok := set[node.Key()]; ok {
 		if foundNode != node {
-			return errors.New("repeated key")
+			return &DuplicateKeyError{Kind: KindNode, Key: node.Key()}
 		}
 		return nil
 	}
@@ -77,7 +81,8 @@ func bfs[T comparable](set map[T]Node[T], node Node[T], f func(node Node[T]) err
 }
 
 type directedGraph[T comparable] struct {
-	nodesMap map[T]Node[T]
+	nodesMap   map[T]Node[T]
+	parentsMap map[T][]Node[T]
 }
 
 func (g *directedGraph[T]) Node(key T) (Node[T], bool) {
@@ -92,3 +97,19 @@ func (g *directedGraph[T]) Nodes() []Node[T] {
 	}
 	return nodes
 }
+
+func (g *directedGraph[T]) Parents(key T) []Node[T] {
+	parents := make([]Node[T], len(g.parentsMap[key]))
+	copy(parents, g.parentsMap[key])
+	return parents
+}
+
+func (g *directedGraph[T]) InDegree(key T) int { return len(g.parentsMap[key]) }
+
+func (g *directedGraph[T]) OutDegree(key T) int {
+	n, ok := g.nodesMap[key]
+	if !ok {
+		return 0
+	}
+	return len(n.Children())
+}
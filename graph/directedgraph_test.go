@@ -162,4 +162,25 @@ func TestDirectedGraph(t *testing.T) {
 		}
 
 	})
+	t.Run("parents and degrees", func(t *testing.T) {
+		creator := graph.NewDirectedGraphCreator(map[int][]int{1: {2, 3}, 2: {3}, 3: {}})
+		directedGraph, err := graph.NewDirectedGraphFromCreator(creator)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		parents := directedGraph.Parents(3)
+		sort.SliceStable(parents, func(i, j int) bool { return parents[i].Key() < parents[j].Key() })
+		if len(parents) != 2 || parents[0].Key() != 1 || parents[1].Key() != 2 {
+			t.Fatal("n1 and n2 must be parents of n3")
+		}
+		if len(directedGraph.Parents(1)) != 0 {
+			t.Fatal("n1 must have no parents")
+		}
+
+		if directedGraph.InDegree(3) != 2 || directedGraph.OutDegree(3) != 0 ||
+			directedGraph.InDegree(1) != 0 || directedGraph.OutDegree(1) != 2 {
+			t.Fatal("incorrect degree")
+		}
+	})
 }
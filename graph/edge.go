@@ -1,29 +1,54 @@
 package graph
 
-type Edge[K, T comparable] interface {
+import "encoding/json"
+
+type Edge[K, T comparable, W Weight] interface {
 	Key() K
-	Weight() float64
+	Weight() W
+	Attributes() Attributes
 	From() Node[T]
 	To() Node[T]
 }
 
-func NewEdge[K, T comparable](key K, weight float64, from, to Node[T]) Edge[K, T] {
-	return &edge[K, T]{
-		key:    key,
-		weight: weight,
-		from:   from,
-		to:     to,
+func NewEdge[K, T comparable](key K, weight float64, from, to Node[T]) Edge[K, T, float64] {
+	return NewEdgeOf(key, weight, from, to)
+}
+
+func NewEdgeOf[K, T comparable, W Weight](key K, weight W, from, to Node[T]) Edge[K, T, W] {
+	return NewEdgeWithAttributes(key, weight, nil, from, to)
+}
+
+func NewEdgeWithAttributes[K, T comparable, W Weight](key K, weight W, attributes Attributes, from, to Node[T]) Edge[K, T, W] {
+	return &edge[K, T, W]{
+		key:        key,
+		weight:     weight,
+		attributes: attributes.Clone(),
+		from:       from,
+		to:         to,
 	}
 }
 
-type edge[K, T comparable] struct {
-	key    K
-	weight float64
-	from   Node[T]
-	to     Node[T]
+type edge[K, T comparable, W Weight] struct {
+	key        K
+	weight     W
+	attributes Attributes
+	from       Node[T]
+	to         Node[T]
 }
 
-func (e *edge[K, T]) Key() K          { return e.key }
-func (e *edge[K, T]) Weight() float64 { return e.weight }
-func (e *edge[K, T]) From() Node[T]   { return e.from }
-func (e *edge[K, T]) To() Node[T]     { return e.to }
+func (e *edge[K, T, W]) Key() K                 { return e.key }
+func (e *edge[K, T, W]) Weight() W              { return e.weight }
+func (e *edge[K, T, W]) Attributes() Attributes { return e.attributes }
+func (e *edge[K, T, W]) From() Node[T]          { return e.from }
+func (e *edge[K, T, W]) To() Node[T]            { return e.to }
+
+// MarshalJSON encodes ends of edge by keys, so nodes are encoded once by graph nodes.
+func (e *edge[K, T, W]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Key        K          `json:"key"`
+		Weight     W          `json:"weight"`
+		Attributes Attributes `json:"attributes,omitempty"`
+		From       T          `json:"from"`
+		To         T          `json:"to"`
+	}{e.key, e.weight, e.attributes, e.from.Key(), e.to.Key()})
+}
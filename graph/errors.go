@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors, every error of package wraps one of them, so they could be checked via errors.Is.
+var (
+	ErrDuplicateKey      = errors.New("duplicate key")
+	ErrMissingEdge       = errors.New("missing edge")
+	ErrUnknownNode       = errors.New("unknown node")
+	ErrDirectionMismatch = errors.New("direction mismatch")
+	ErrForeignNode       = errors.New("foreign node")
+)
+
+// Kinds of repeated keys.
+const (
+	KindNode = "node"
+	KindEdge = "edge"
+	KindPath = "path"
+)
+
+// DuplicateKeyError is returned when key of node or edge is repeated or when simple graph has parallel edges,
+// in the last case Kind is KindPath and From and To are keys of nodes.
+type DuplicateKeyError struct {
+	Kind     string
+	Key      any
+	From, To any
+}
+
+func (e *DuplicateKeyError) Error() string {
+	if e.Kind == KindPath {
+		return fmt.Sprintf("repeated edge from %v to %v", e.From, e.To)
+	}
+	return fmt.Sprintf("repeated %s key %v", e.Kind, e.Key)
+}
+
+func (e *DuplicateKeyError) Unwrap() error { return ErrDuplicateKey }
+
+// MissingEdgeError is returned when edge is not found by key or by its ends.
+// Link is true when edge exists, but nodes have no link between its ends.
+type MissingEdgeError struct {
+	Key      any
+	From, To any
+	Link     bool
+}
+
+func (e *MissingEdgeError) Error() string {
+	switch {
+	case e.Link:
+		return fmt.Sprintf("link from %v to %v is not found", e.From, e.To)
+	case e.Key != nil:
+		return fmt.Sprintf("edge %v is not found", e.Key)
+	default:
+		return fmt.Sprintf("edge from %v to %v is not found", e.From, e.To)
+	}
+}
+
+func (e *MissingEdgeError) Unwrap() error { return ErrMissingEdge }
+
+type UnknownNodeError struct {
+	Key any
+}
+
+func (e *UnknownNodeError) Error() string { return fmt.Sprintf("node %v is not found", e.Key) }
+
+func (e *UnknownNodeError) Unwrap() error { return ErrUnknownNode }
+
+// DirectionMismatchError is returned when weighted operation gets directed and undirected graphs,
+// edges of undirected graph can't keep their keys in directed result.
+type DirectionMismatchError struct {
+	Operation string
+}
+
+func (e *DirectionMismatchError) Error() string {
+	return fmt.Sprintf("%s of directed and undirected graphs", e.Operation)
+}
+
+func (e *DirectionMismatchError) Unwrap() error { return ErrDirectionMismatch }
+
+// ForeignNodeError is returned by mutable graph for node which isn't created by NewNode or for end of edge
+// which isn't the node of graph itself, graph can't keep children of such node consistent with edges.
+type ForeignNodeError struct {
+	Key any
+}
+
+func (e *ForeignNodeError) Error() string {
+	return fmt.Sprintf("node %v is foreign for mutable graph", e.Key)
+}
+
+func (e *ForeignNodeError) Unwrap() error { return ErrForeignNode }
@@ -0,0 +1,61 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestErrors(t *testing.T) {
+	t.Run("duplicate node key", func(t *testing.T) {
+		_, err := graph.NewDirectedGraph(graph.NewNode(1), graph.NewNode(1))
+		var duplicateKeyErr *graph.DuplicateKeyError
+		if !errors.Is(err, graph.ErrDuplicateKey) || !errors.As(err, &duplicateKeyErr) {
+			t.Fatal("err must be duplicate key error")
+		}
+		if duplicateKeyErr.Kind != graph.KindNode || duplicateKeyErr.Key != 1 {
+			t.Fatal("incorrect duplicate key")
+		}
+	})
+
+	t.Run("parallel edges in simple graph", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{
+			graph.NewEdge(1, 1.0, n1, n2),
+			graph.NewEdge(2, 1.0, n1, n2),
+		})
+		var duplicateKeyErr *graph.DuplicateKeyError
+		if !errors.As(err, &duplicateKeyErr) || duplicateKeyErr.Kind != graph.KindPath || duplicateKeyErr.From != 1 || duplicateKeyErr.To != 2 {
+			t.Fatal("err must be duplicate path error")
+		}
+	})
+
+	t.Run("missing edge", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{})
+		var missingEdgeErr *graph.MissingEdgeError
+		if !errors.Is(err, graph.ErrMissingEdge) || !errors.As(err, &missingEdgeErr) {
+			t.Fatal("err must be missing edge error")
+		}
+		if missingEdgeErr.From != 1 || missingEdgeErr.To != 2 || missingEdgeErr.Link {
+			t.Fatal("incorrect missing edge")
+		}
+
+		mutableGraph, _ := graph.NewMutableWeightedGraph[int, int, float64](nil, nil)
+		if err = mutableGraph.RemoveEdge(1); !errors.As(err, &missingEdgeErr) || missingEdgeErr.Key != 1 {
+			t.Fatal("err must be missing edge error")
+		}
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		mutableGraph, _ := graph.NewMutableWeightedGraph[int, int, float64](nil, nil)
+		_, err := mutableGraph.AddEdge(1, 1.0, 1, 2)
+		var unknownNodeErr *graph.UnknownNodeError
+		if !errors.Is(err, graph.ErrUnknownNode) || !errors.As(err, &unknownNodeErr) || unknownNodeErr.Key != 1 {
+			t.Fatal("err must be unknown node error")
+		}
+	})
+}
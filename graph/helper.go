@@ -5,25 +5,81 @@ func NewDirectedGraphCreator[T comparable](structure map[T][]T) DirectedGraphCre
 }
 
 type DirectedGraphCreator[T comparable] struct {
-	structure map[T][]T
+	structure  map[T][]T
+	attributes map[T]Attributes
 }
 
-func NewWeightedGraphCreator[K, T comparable](structure map[T][]Length[T], uniqueKGen func() K) WeightedGraphCreator[K, T] {
-	return WeightedGraphCreator[K, T]{structure: structure, uniqueKGen: uniqueKGen}
+// WithNodeAttributes returns creator which attaches given attributes to nodes.
+func (c DirectedGraphCreator[T]) WithNodeAttributes(attributes map[T]Attributes) DirectedGraphCreator[T] {
+	c.attributes = attributes
+	return c
 }
 
-type WeightedGraphCreator[K, T comparable] struct {
-	structure  map[T][]Length[T]
+func NewUndirectedGraphCreator[T comparable](structure map[T][]T) UndirectedGraphCreator[T] {
+	return UndirectedGraphCreator[T]{structure: structure}
+}
+
+type UndirectedGraphCreator[T comparable] struct {
+	structure  map[T][]T
+	attributes map[T]Attributes
+}
+
+// WithNodeAttributes returns creator which attaches given attributes to nodes.
+func (c UndirectedGraphCreator[T]) WithNodeAttributes(attributes map[T]Attributes) UndirectedGraphCreator[T] {
+	c.attributes = attributes
+	return c
+}
+
+func NewWeightedGraphCreator[K, T comparable, W Weight](structure map[T][]Length[T, W], uniqueKGen func() K) WeightedGraphCreator[K, T, W] {
+	return WeightedGraphCreator[K, T, W]{structure: structure, uniqueKGen: uniqueKGen}
+}
+
+type WeightedGraphCreator[K, T comparable, W Weight] struct {
+	structure  map[T][]Length[T, W]
 	uniqueKGen func() K
+	attributes map[T]Attributes
+}
+
+// WithNodeAttributes returns creator which attaches given attributes to nodes.
+func (c WeightedGraphCreator[K, T, W]) WithNodeAttributes(attributes map[T]Attributes) WeightedGraphCreator[K, T, W] {
+	c.attributes = attributes
+	return c
+}
+
+func NewUndirectedWeightedGraphCreator[K, T comparable, W Weight](structure map[T][]Length[T, W], uniqueKGen func() K) UndirectedWeightedGraphCreator[K, T, W] {
+	return UndirectedWeightedGraphCreator[K, T, W]{structure: structure, uniqueKGen: uniqueKGen}
+}
+
+type UndirectedWeightedGraphCreator[K, T comparable, W Weight] struct {
+	structure  map[T][]Length[T, W]
+	uniqueKGen func() K
+	attributes map[T]Attributes
+}
+
+// WithNodeAttributes returns creator which attaches given attributes to nodes.
+func (c UndirectedWeightedGraphCreator[K, T, W]) WithNodeAttributes(attributes map[T]Attributes) UndirectedWeightedGraphCreator[K, T, W] {
+	c.attributes = attributes
+	return c
+}
+
+func NewLength[T comparable](to T, weight float64) Length[T, float64] {
+	return NewLengthOf(to, weight)
+}
+
+func NewLengthOf[T comparable, W Weight](to T, weight W) Length[T, W] {
+	return Length[T, W]{to: to, weight: weight}
 }
 
-func NewLength[T comparable](to T, weight float64) Length[T] {
-	return Length[T]{to: to, weight: weight}
+type Length[T comparable, W Weight] struct {
+	to         T
+	weight     W
+	attributes Attributes
 }
 
-type Length[T comparable] struct {
-	to     T
-	weight float64
+// WithAttributes returns length whose edge gets given attributes.
+func (l Length[T, W]) WithAttributes(attributes Attributes) Length[T, W] {
+	l.attributes = attributes
+	return l
 }
 
 func newPath[T comparable](from, to T) path[T] {
@@ -0,0 +1,167 @@
+package graph
+
+type MutableWeightedGraph[K, T comparable, W Weight] interface {
+	WeightedGraph[K, T, W]
+
+	AddNode(key T) (Node[T], error)
+	RemoveNode(key T) error
+	AddEdge(key K, weight W, from, to T) (Edge[K, T, W], error)
+	RemoveEdge(key K) error
+	SetWeight(key K, weight W) error
+}
+
+func NewMutableWeightedGraph[K, T comparable, W Weight](ns []Node[T], edges []Edge[K, T, W]) (MutableWeightedGraph[K, T, W], error) {
+	return newMutableWeightedGraph(ns, edges, false)
+}
+
+func NewMutableWeightedMultigraph[K, T comparable, W Weight](ns []Node[T], edges []Edge[K, T, W]) (MutableWeightedGraph[K, T, W], error) {
+	return newMutableWeightedGraph(ns, edges, true)
+}
+
+func newMutableWeightedGraph[K, T comparable, W Weight](ns []Node[T], edges []Edge[K, T, W], multi bool) (MutableWeightedGraph[K, T, W], error) {
+	graph, err := newWeightedGraph(ns, edges, multi)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range graph.Nodes() {
+		if _, ok := n.(*node[T]); !ok {
+			return nil, &ForeignNodeError{Key: n.Key()}
+		}
+	}
+	for _, e := range edges {
+		for _, end := range []Node[T]{e.From(), e.To()} {
+			if n, _ := graph.Node(end.Key()); n != end {
+				return nil, &ForeignNodeError{Key: end.Key()}
+			}
+		}
+	}
+	return &mutableWeightedGraph[K, T, W]{
+		weightedGraph: graph,
+		directed:      graph.DirectedGraph.(*directedGraph[T]),
+	}, nil
+}
+
+type mutableWeightedGraph[K, T comparable, W Weight] struct {
+	*weightedGraph[K, T, W]
+
+	directed *directedGraph[T]
+}
+
+func (m *mutableWeightedGraph[K, T, W]) AddNode(key T) (Node[T], error) {
+	if _, ok := m.directed.nodesMap[key]; ok {
+		return nil, &DuplicateKeyError{Kind: KindNode, Key: key}
+	}
+	n := NewNode(key)
+	m.directed.nodesMap[key] = n
+	return n, nil
+}
+
+func (m *mutableWeightedGraph[K, T, W]) RemoveNode(key T) error {
+	if _, ok := m.directed.nodesMap[key]; !ok {
+		return &UnknownNodeError{Key: key}
+	}
+	edges := append(append([]Edge[K, T, W](nil), m.outEdges[key]...), m.inEdges[key]...)
+	for _, e := range edges {
+		// loop is both out and in edge
+		if _, ok := m.edgesMap[e.Key()]; ok {
+			m.removeEdge(e)
+		}
+	}
+	delete(m.directed.nodesMap, key)
+	delete(m.directed.parentsMap, key)
+	delete(m.outEdges, key)
+	delete(m.inEdges, key)
+	return nil
+}
+
+func (m *mutableWeightedGraph[K, T, W]) AddEdge(key K, weight W, from, to T) (Edge[K, T, W], error) {
+	if _, ok := m.edgesMap[key]; ok {
+		return nil, &DuplicateKeyError{Kind: KindEdge, Key: key}
+	}
+	fromNode, ok := m.directed.nodesMap[from]
+	if !ok {
+		return nil, &UnknownNodeError{Key: from}
+	}
+	toNode, ok := m.directed.nodesMap[to]
+	if !ok {
+		return nil, &UnknownNodeError{Key: to}
+	}
+	p := newPath(from, to)
+	if _, ok := m.edgesPaths[p]; ok && !m.multi {
+		return nil, &DuplicateKeyError{Kind: KindPath, From: from, To: to}
+	}
+
+	e := NewEdgeOf(key, weight, fromNode, toNode)
+	if len(m.edgesPaths[p]) == 0 {
+		fromNode.AddChildren(toNode)
+		m.directed.parentsMap[to] = append(m.directed.parentsMap[to], fromNode)
+	}
+	m.edgesPaths[p], m.edgesMap[key] = append(m.edgesPaths[p], e), e
+	m.outEdges[from], m.inEdges[to] = append(m.outEdges[from], e), append(m.inEdges[to], e)
+	return e, nil
+}
+
+func (m *mutableWeightedGraph[K, T, W]) RemoveEdge(key K) error {
+	e, ok := m.edgesMap[key]
+	if !ok {
+		return &MissingEdgeError{Key: key}
+	}
+	m.removeEdge(e)
+	return nil
+}
+
+func (m *mutableWeightedGraph[K, T, W]) SetWeight(key K, weight W) error {
+	old, ok := m.edgesMap[key]
+	if !ok {
+		return &MissingEdgeError{Key: key}
+	}
+	p := newPath(old.From().Key(), old.To().Key())
+	var e Edge[K, T, W] = &edge[K, T, W]{key: key, weight: weight, attributes: old.Attributes(), from: m.directed.nodesMap[p.from], to: m.directed.nodesMap[p.to]}
+	m.edgesMap[key] = e
+	replaceEdge(m.edgesPaths[p], old, e)
+	replaceEdge(m.outEdges[p.from], old, e)
+	replaceEdge(m.inEdges[p.to], old, e)
+	return nil
+}
+
+func (m *mutableWeightedGraph[K, T, W]) removeEdge(e Edge[K, T, W]) {
+	p := newPath(e.From().Key(), e.To().Key())
+	delete(m.edgesMap, e.Key())
+	m.edgesPaths[p] = withoutEdge(m.edgesPaths[p], e)
+	m.outEdges[p.from] = withoutEdge(m.outEdges[p.from], e)
+	m.inEdges[p.to] = withoutEdge(m.inEdges[p.to], e)
+	if len(m.edgesPaths[p]) == 0 {
+		delete(m.edgesPaths, p)
+		from, to := m.directed.nodesMap[p.from], m.directed.nodesMap[p.to]
+		from.(*node[T]).removeChild(to)
+		m.directed.parentsMap[p.to] = withoutNode(m.directed.parentsMap[p.to], from)
+	}
+}
+
+func replaceEdge[K, T comparable, W Weight](edges []Edge[K, T, W], old, e Edge[K, T, W]) {
+	for i := range edges {
+		if edges[i] == old {
+			edges[i] = e
+		}
+	}
+}
+
+func withoutEdge[K, T comparable, W Weight](edges []Edge[K, T, W], e Edge[K, T, W]) []Edge[K, T, W] {
+	res := make([]Edge[K, T, W], 0, len(edges))
+	for _, candidate := range edges {
+		if candidate != e {
+			res = append(res, candidate)
+		}
+	}
+	return res
+}
+
+func withoutNode[T comparable](nodes []Node[T], n Node[T]) []Node[T] {
+	res := make([]Node[T], 0, len(nodes))
+	for _, candidate := range nodes {
+		if candidate != n {
+			res = append(res, candidate)
+		}
+	}
+	return res
+}
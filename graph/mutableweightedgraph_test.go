@@ -0,0 +1,214 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestMutableWeightedGraph(t *testing.T) {
+	newGraph := func(t *testing.T) graph.MutableWeightedGraph[int, int, float64] {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+
+		mutableGraph, err := graph.NewMutableWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{graph.NewEdge(1, 2.0, n1, n2)})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		return mutableGraph
+	}
+
+	t.Run("add node and edge", func(t *testing.T) {
+		mutableGraph := newGraph(t)
+
+		n3, err := mutableGraph.AddNode(3)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if _, err = mutableGraph.AddNode(3); err == nil {
+			t.Fatal("err must be not nil")
+		}
+
+		e, err := mutableGraph.AddEdge(2, 3.0, 2, 3)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		n2, _ := mutableGraph.Node(2)
+		if len(n2.Children()) != 1 || n2.Children()[0] != n3 {
+			t.Fatal("n3 must be child of n2")
+		}
+		if found, ok := mutableGraph.FindEdge(2, 3); !ok || found != e {
+			t.Fatal("must be equal")
+		}
+		if len(mutableGraph.OutEdges(2)) != 1 || len(mutableGraph.Edges()) != 2 {
+			t.Fatal("inconsistent edges")
+		}
+
+		if _, err = mutableGraph.AddEdge(3, 3.0, 2, 3); err == nil {
+			t.Fatal("parallel edge must be rejected")
+		}
+		if _, err = mutableGraph.AddEdge(1, 3.0, 3, 1); err == nil {
+			t.Fatal("repeated edge key must be rejected")
+		}
+		if _, err = mutableGraph.AddEdge(3, 3.0, 3, 4); err == nil {
+			t.Fatal("unknown node must be rejected")
+		}
+	})
+
+	t.Run("remove edge", func(t *testing.T) {
+		mutableGraph := newGraph(t)
+
+		if err := mutableGraph.RemoveEdge(1); err != nil {
+			t.Fatal("err must be nil")
+		}
+		if err := mutableGraph.RemoveEdge(1); err == nil {
+			t.Fatal("err must be not nil")
+		}
+
+		n1, _ := mutableGraph.Node(1)
+		if len(n1.Children()) != 0 {
+			t.Fatal("n1 must have no children")
+		}
+		if _, ok := mutableGraph.FindEdge(1, 2); ok || len(mutableGraph.Edges()) != 0 || len(mutableGraph.OutEdges(1)) != 0 {
+			t.Fatal("edge must be removed")
+		}
+	})
+
+	t.Run("remove node", func(t *testing.T) {
+		mutableGraph := newGraph(t)
+		if _, err := mutableGraph.AddEdge(2, 3.0, 2, 1); err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		if err := mutableGraph.RemoveNode(2); err != nil {
+			t.Fatal("err must be nil")
+		}
+		if err := mutableGraph.RemoveNode(2); err == nil {
+			t.Fatal("err must be not nil")
+		}
+
+		n1, _ := mutableGraph.Node(1)
+		if len(n1.Children()) != 0 || len(mutableGraph.Nodes()) != 1 || len(mutableGraph.Edges()) != 0 {
+			t.Fatal("node and its edges must be removed")
+		}
+	})
+
+	t.Run("remove node with loop", func(t *testing.T) {
+		mutableGraph := newGraph(t)
+		if _, err := mutableGraph.AddEdge(2, 3.0, 2, 2); err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		if err := mutableGraph.RemoveNode(2); err != nil {
+			t.Fatal("err must be nil")
+		}
+		n1, _ := mutableGraph.Node(1)
+		if len(n1.Children()) != 0 || len(mutableGraph.Edges()) != 0 || mutableGraph.OutDegree(1) != 0 {
+			t.Fatal("node, its loop and in edges must be removed")
+		}
+	})
+
+	t.Run("foreign node", func(t *testing.T) {
+		_, err := graph.NewMutableWeightedGraph([]graph.Node[int]{foreignNode(1)}, []graph.Edge[int, int, float64]{})
+		var foreignNodeErr *graph.ForeignNodeError
+		if !errors.Is(err, graph.ErrForeignNode) || !errors.As(err, &foreignNodeErr) || foreignNodeErr.Key != 1 {
+			t.Fatal("err must be foreign node error")
+		}
+	})
+
+	t.Run("foreign ends of edge", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		// ends of edges have the same keys, but they aren't nodes of graph
+		for _, e := range []graph.Edge[int, int, float64]{
+			graph.NewEdge[int, int](1, 2.0, foreignNode(1), n2),
+			graph.NewEdge(1, 2.0, graph.NewNode(1), n2),
+		} {
+			_, err := graph.NewMutableWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e})
+			var foreignNodeErr *graph.ForeignNodeError
+			if !errors.As(err, &foreignNodeErr) || foreignNodeErr.Key != 1 {
+				t.Fatal("err must be foreign node error")
+			}
+		}
+	})
+
+	t.Run("set weight", func(t *testing.T) {
+		mutableGraph := newGraph(t)
+
+		if err := mutableGraph.SetWeight(1, 5.0); err != nil {
+			t.Fatal("err must be nil")
+		}
+		if err := mutableGraph.SetWeight(2, 5.0); err == nil {
+			t.Fatal("err must be not nil")
+		}
+
+		e, _ := mutableGraph.Edge(1)
+		found, _ := mutableGraph.FindEdge(1, 2)
+		outEdges := mutableGraph.OutEdges(1)
+		if e.Weight() != 5.0 || found != e || outEdges[0] != e {
+			t.Fatal("weight must be changed in all indices")
+		}
+	})
+
+	t.Run("multigraph", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+
+		multigraph, err := graph.NewMutableWeightedMultigraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{graph.NewEdge(1, 2.0, n1, n2)})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if _, err = multigraph.AddEdge(2, 1.0, 1, 2); err != nil {
+			t.Fatal("err must be nil")
+		}
+		if len(n1.Children()) != 1 || len(multigraph.FindEdges(1, 2)) != 2 {
+			t.Fatal("parallel edge must share single link")
+		}
+
+		if err = multigraph.RemoveEdge(1); err != nil {
+			t.Fatal("err must be nil")
+		}
+		if len(n1.Children()) != 1 {
+			t.Fatal("link must be kept while parallel edge exists")
+		}
+	})
+	t.Run("parents and in edges", func(t *testing.T) {
+		mutableGraph := newGraph(t)
+		if _, err := mutableGraph.AddNode(3); err != nil {
+			t.Fatal("err must be nil")
+		}
+		if _, err := mutableGraph.AddEdge(2, 3.0, 3, 2); err != nil {
+			t.Fatal("err must be nil")
+		}
+		if len(mutableGraph.Parents(2)) != 2 || len(mutableGraph.InEdges(2)) != 2 {
+			t.Fatal("n1 and n3 must be parents of n2")
+		}
+
+		if err := mutableGraph.SetWeight(2, 4.0); err != nil {
+			t.Fatal("err must be nil")
+		}
+		e, _ := mutableGraph.Edge(2)
+		inEdges := mutableGraph.InEdges(2)
+		if inEdges[0] != e && inEdges[1] != e {
+			t.Fatal("weight must be changed in in edges")
+		}
+
+		if err := mutableGraph.RemoveNode(1); err != nil {
+			t.Fatal("err must be nil")
+		}
+		parents := mutableGraph.Parents(2)
+		if len(parents) != 1 || parents[0].Key() != 3 || mutableGraph.InDegree(2) != 1 {
+			t.Fatal("n3 must be single parent of n2")
+		}
+	})
+}
+
+// foreignNode is node implemented outside of package, mutable graph can't unlink its children.
+type foreignNode int
+
+func (n foreignNode) Key() int                            { return int(n) }
+func (n foreignNode) Attributes() graph.Attributes        { return nil }
+func (n foreignNode) Children() []graph.Node[int]         { return nil }
+func (n foreignNode) AddChildren(node ...graph.Node[int]) {}
@@ -1,23 +1,49 @@
 package graph
 
+import "encoding/json"
+
 type Node[T comparable] interface {
 	Key() T
+	Attributes() Attributes
 	Children() []Node[T]
 	AddChildren(node ...Node[T])
 }
 
 func NewNode[T comparable](key T, children ...Node[T]) Node[T] {
+	return NewNodeWithAttributes(key, nil, children...)
+}
+
+func NewNodeWithAttributes[T comparable](key T, attributes Attributes, children ...Node[T]) Node[T] {
 	return &node[T]{
-		key:      key,
-		children: children,
+		key:        key,
+		attributes: attributes.Clone(),
+		children:   children,
 	}
 }
 
 type node[T comparable] struct {
-	key      T
-	children []Node[T]
+	key        T
+	attributes Attributes
+	children   []Node[T]
 }
 
 func (n *node[T]) Key() T                      { return n.key }
+func (n *node[T]) Attributes() Attributes      { return n.attributes }
 func (n *node[T]) Children() []Node[T]         { return n.children }
 func (n *node[T]) AddChildren(node ...Node[T]) { n.children = append(n.children, node...) }
+
+// removeChild is used by mutable graph only, so children of node always match edges of graph.
+func (n *node[T]) removeChild(child Node[T]) { n.children = withoutNode(n.children, child) }
+
+// MarshalJSON encodes key, attributes and keys of children, since nodes of graph with cycles can't be nested.
+func (n *node[T]) MarshalJSON() ([]byte, error) {
+	children := make([]T, 0, len(n.children))
+	for _, child := range n.children {
+		children = append(children, child.Key())
+	}
+	return json.Marshal(struct {
+		Key        T          `json:"key"`
+		Attributes Attributes `json:"attributes,omitempty"`
+		Children   []T        `json:"children"`
+	}{n.key, n.attributes, children})
+}
@@ -0,0 +1,275 @@
+package graph
+
+// Operations build new graphs with fresh nodes and edges. Edge keys, weights and attributes are preserved,
+// attributes are copied shallowly. Undirected graphs and multigraphs stay the same kind, while weighted operations
+// with directed and undirected graph return DirectionMismatchError.
+
+func Transpose[T comparable](g DirectedGraph[T]) (DirectedGraph[T], error) {
+	keys, links := nodeKeys(g), make([]path[T], 0)
+	for _, l := range graphLinks(g) {
+		links = append(links, newPath(l.to, l.from))
+	}
+	return buildDirectedGraph(keys, nodeAttributes(g), links, isUndirected(g))
+}
+
+func InducedSubgraph[T comparable](g DirectedGraph[T], keys []T) (DirectedGraph[T], error) {
+	set, err := keysSet(g, keys)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]path[T], 0)
+	for _, l := range graphLinks(g) {
+		if _, ok := set[l.from]; !ok {
+			continue
+		}
+		if _, ok := set[l.to]; ok {
+			links = append(links, l)
+		}
+	}
+	return buildDirectedGraph(keys, nodeAttributes(g), links, isUndirected(g))
+}
+
+func Union[T comparable](lhs, rhs DirectedGraph[T]) (DirectedGraph[T], error) {
+	keys, links := append(nodeKeys(lhs), nodeKeys(rhs)...), append(graphLinks(lhs), graphLinks(rhs)...)
+	return buildDirectedGraph(keys, nodeAttributes(lhs, rhs), links, isUndirected(lhs) && isUndirected(rhs))
+}
+
+func Intersection[T comparable](lhs, rhs DirectedGraph[T]) (DirectedGraph[T], error) {
+	keys := make([]T, 0)
+	for _, n := range lhs.Nodes() {
+		if _, ok := rhs.Node(n.Key()); ok {
+			keys = append(keys, n.Key())
+		}
+	}
+	rhsLinks := make(map[path[T]]struct{})
+	for _, l := range graphLinks(rhs) {
+		rhsLinks[l] = struct{}{}
+	}
+	links := make([]path[T], 0)
+	for _, l := range graphLinks(lhs) {
+		if _, ok := rhsLinks[l]; ok {
+			links = append(links, l)
+		}
+	}
+	return buildDirectedGraph(keys, nodeAttributes(lhs), links, isUndirected(lhs) && isUndirected(rhs))
+}
+
+func TransposeWeighted[K, T comparable, W Weight](g WeightedGraph[K, T, W]) (WeightedGraph[K, T, W], error) {
+	edges := make([]edgeSettings[K, T, W], 0)
+	for _, e := range g.Edges() {
+		edges = append(edges, edgeSettings[K, T, W]{key: e.Key(), weight: e.Weight(), attributes: e.Attributes(), from: e.To().Key(), to: e.From().Key()})
+	}
+	return buildWeightedGraph(nodeKeys[T](g), nodeAttributes[T](g), edges, isMultigraph(g), isUndirected[T](g))
+}
+
+func InducedWeightedSubgraph[K, T comparable, W Weight](g WeightedGraph[K, T, W], keys []T) (WeightedGraph[K, T, W], error) {
+	set, err := keysSet[T](g, keys)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]edgeSettings[K, T, W], 0)
+	for _, e := range g.Edges() {
+		if _, ok := set[e.From().Key()]; !ok {
+			continue
+		}
+		if _, ok := set[e.To().Key()]; ok {
+			edges = append(edges, toEdgeSettings(e))
+		}
+	}
+	return buildWeightedGraph(keys, nodeAttributes[T](g), edges, isMultigraph(g), isUndirected[T](g))
+}
+
+func EdgeSubgraph[K, T comparable, W Weight](g WeightedGraph[K, T, W], edgeKeys []K) (WeightedGraph[K, T, W], error) {
+	keys, edges := make([]T, 0), make([]edgeSettings[K, T, W], 0, len(edgeKeys))
+	for _, key := range edgeKeys {
+		e, ok := g.Edge(key)
+		if !ok {
+			return nil, &MissingEdgeError{Key: key}
+		}
+		keys, edges = append(keys, e.From().Key(), e.To().Key()), append(edges, toEdgeSettings(e))
+	}
+	return buildWeightedGraph(keys, nodeAttributes[T](g), edges, isMultigraph(g), isUndirected[T](g))
+}
+
+func UnionWeighted[K, T comparable, W Weight](lhs, rhs WeightedGraph[K, T, W]) (WeightedGraph[K, T, W], error) {
+	undirected := isUndirected[T](lhs)
+	if undirected != isUndirected[T](rhs) {
+		return nil, &DirectionMismatchError{Operation: "union"}
+	}
+	edges := make([]edgeSettings[K, T, W], 0)
+	for _, e := range lhs.Edges() {
+		edges = append(edges, toEdgeSettings(e))
+	}
+	for _, e := range rhs.Edges() {
+		if lhsEdge, ok := lhs.Edge(e.Key()); ok {
+			if !toEdgeSettings(lhsEdge).equal(toEdgeSettings(e), undirected) {
+				return nil, &DuplicateKeyError{Kind: KindEdge, Key: e.Key()}
+			}
+			continue
+		}
+		edges = append(edges, toEdgeSettings(e))
+	}
+	keys := append(nodeKeys[T](lhs), nodeKeys[T](rhs)...)
+	return buildWeightedGraph(keys, nodeAttributes[T](lhs, rhs), edges, isMultigraph(lhs) || isMultigraph(rhs), undirected)
+}
+
+func IntersectionWeighted[K, T comparable, W Weight](lhs, rhs WeightedGraph[K, T, W]) (WeightedGraph[K, T, W], error) {
+	undirected := isUndirected[T](lhs)
+	if undirected != isUndirected[T](rhs) {
+		return nil, &DirectionMismatchError{Operation: "intersection"}
+	}
+	keys := make([]T, 0)
+	for _, n := range lhs.Nodes() {
+		if _, ok := rhs.Node(n.Key()); ok {
+			keys = append(keys, n.Key())
+		}
+	}
+	edges := make([]edgeSettings[K, T, W], 0)
+	for _, e := range lhs.Edges() {
+		rhsEdge, ok := rhs.Edge(e.Key())
+		if !ok {
+			continue
+		}
+		if !toEdgeSettings(rhsEdge).equal(toEdgeSettings(e), undirected) {
+			return nil, &DuplicateKeyError{Kind: KindEdge, Key: e.Key()}
+		}
+		edges = append(edges, toEdgeSettings(e))
+	}
+	return buildWeightedGraph(keys, nodeAttributes[T](lhs), edges, isMultigraph(lhs) && isMultigraph(rhs), undirected)
+}
+
+type edgeSettings[K, T comparable, W Weight] struct {
+	key        K
+	weight     W
+	attributes Attributes
+	from, to   T
+}
+
+// equal ignores attributes: edges with the same key, weight and ends are the same edge.
+// Ends of undirected edge could be swapped.
+func (s edgeSettings[K, T, W]) equal(other edgeSettings[K, T, W], undirected bool) bool {
+	if s.key != other.key || s.weight != other.weight {
+		return false
+	}
+	return (s.from == other.from && s.to == other.to) || (undirected && s.from == other.to && s.to == other.from)
+}
+
+func toEdgeSettings[K, T comparable, W Weight](e Edge[K, T, W]) edgeSettings[K, T, W] {
+	return edgeSettings[K, T, W]{key: e.Key(), weight: e.Weight(), attributes: e.Attributes(), from: e.From().Key(), to: e.To().Key()}
+}
+
+func nodeKeys[T comparable](g DirectedGraph[T]) []T {
+	keys := make([]T, 0)
+	for _, n := range g.Nodes() {
+		keys = append(keys, n.Key())
+	}
+	return keys
+}
+
+// nodeAttributes collects attributes of nodes, the first graph containing a node wins.
+func nodeAttributes[T comparable](graphs ...DirectedGraph[T]) map[T]Attributes {
+	attributes := make(map[T]Attributes)
+	for _, g := range graphs {
+		for _, n := range g.Nodes() {
+			if _, ok := attributes[n.Key()]; !ok {
+				attributes[n.Key()] = n.Attributes()
+			}
+		}
+	}
+	return attributes
+}
+
+func graphLinks[T comparable](g DirectedGraph[T]) []path[T] {
+	links := make([]path[T], 0)
+	for _, n := range g.Nodes() {
+		for _, child := range n.Children() {
+			links = append(links, newPath(n.Key(), child.Key()))
+		}
+	}
+	return links
+}
+
+func keysSet[T comparable](g DirectedGraph[T], keys []T) (map[T]struct{}, error) {
+	set := make(map[T]struct{}, len(keys))
+	for _, key := range keys {
+		if _, ok := g.Node(key); !ok {
+			return nil, &UnknownNodeError{Key: key}
+		}
+		set[key] = struct{}{}
+	}
+	return set, nil
+}
+
+func isMultigraph[K, T comparable, W Weight](g WeightedGraph[K, T, W]) bool {
+	if m, ok := g.(interface{ multigraph() bool }); ok {
+		return m.multigraph()
+	}
+	for _, e := range g.Edges() {
+		if len(g.FindEdges(e.From().Key(), e.To().Key())) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func isUndirected[T comparable](g DirectedGraph[T]) bool {
+	_, ok := g.(UndirectedGraph[T])
+	return ok
+}
+
+func buildNodes[T comparable](keys []T, attributes map[T]Attributes, links []path[T]) map[T]Node[T] {
+	nodesMap, linked := make(map[T]Node[T]), make(map[path[T]]struct{})
+	for _, key := range keys {
+		if _, ok := nodesMap[key]; !ok {
+			nodesMap[key] = NewNodeWithAttributes(key, attributes[key])
+		}
+	}
+	for _, l := range links {
+		if _, ok := linked[l]; ok {
+			continue
+		}
+		linked[l] = struct{}{}
+		nodesMap[l.from].AddChildren(nodesMap[l.to])
+	}
+	return nodesMap
+}
+
+// buildDirectedGraph expects links of undirected graph in both directions.
+func buildDirectedGraph[T comparable](keys []T, attributes map[T]Attributes, links []path[T], undirected bool) (DirectedGraph[T], error) {
+	nodes := make([]Node[T], 0)
+	for _, n := range buildNodes(keys, attributes, links) {
+		nodes = append(nodes, n)
+	}
+	if undirected {
+		return NewUndirectedGraph(nodes...)
+	}
+	return NewDirectedGraph(nodes...)
+}
+
+// buildWeightedGraph expects single settings for every undirected edge.
+func buildWeightedGraph[K, T comparable, W Weight](keys []T, attributes map[T]Attributes, settings []edgeSettings[K, T, W], multi, undirected bool) (WeightedGraph[K, T, W], error) {
+	links := make([]path[T], 0, len(settings))
+	for _, s := range settings {
+		links = append(links, newPath(s.from, s.to))
+		if undirected {
+			links = append(links, newPath(s.to, s.from))
+		}
+	}
+	nodesMap := buildNodes(keys, attributes, links)
+
+	nodes, edges := make([]Node[T], 0, len(nodesMap)), make([]Edge[K, T, W], 0, len(settings))
+	for _, n := range nodesMap {
+		nodes = append(nodes, n)
+	}
+	for _, s := range settings {
+		edges = append(edges, NewEdgeWithAttributes(s.key, s.weight, s.attributes, nodesMap[s.from], nodesMap[s.to]))
+	}
+	if undirected {
+		return NewUndirectedWeightedGraph(nodes, edges)
+	}
+	graph, err := newWeightedGraph(nodes, edges, multi)
+	if err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
@@ -0,0 +1,255 @@
+package graph_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestDirectedGraphOperations(t *testing.T) {
+	// 1 -> 2 -> 3
+	lhs, err := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(map[int][]int{1: {2}, 2: {3}}))
+	if err != nil {
+		t.Fatal("err must be nil")
+	}
+	// 2 -> 3 -> 4
+	rhs, err := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(map[int][]int{2: {3}, 3: {4}}))
+	if err != nil {
+		t.Fatal("err must be nil")
+	}
+
+	t.Run("transpose", func(t *testing.T) {
+		transposed, err := graph.Transpose(lhs)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, transposed, map[int][]int{1: {}, 2: {1}, 3: {2}})
+
+		n1, _ := lhs.Node(1)
+		if len(n1.Children()) != 1 {
+			t.Fatal("original graph must not be changed")
+		}
+	})
+
+	t.Run("induced subgraph", func(t *testing.T) {
+		subgraph, err := graph.InducedSubgraph(lhs, []int{1, 2})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, subgraph, map[int][]int{1: {2}, 2: {}})
+
+		if _, err = graph.InducedSubgraph(lhs, []int{1, 4}); err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+
+	t.Run("union", func(t *testing.T) {
+		union, err := graph.Union(lhs, rhs)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, union, map[int][]int{1: {2}, 2: {3}, 3: {4}, 4: {}})
+	})
+
+	t.Run("intersection", func(t *testing.T) {
+		intersection, err := graph.Intersection(lhs, rhs)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, intersection, map[int][]int{2: {3}, 3: {}})
+	})
+}
+
+func TestWeightedGraphOperations(t *testing.T) {
+	newGraph := func(t *testing.T, edges ...graph.Edge[int, int, float64]) graph.WeightedGraph[int, int, float64] {
+		nodes := make([]graph.Node[int], 0)
+		for _, e := range edges {
+			e.From().AddChildren(e.To())
+			nodes = append(nodes, e.From())
+		}
+		weightedGraph, err := graph.NewWeightedGraph(nodes, edges)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		return weightedGraph
+	}
+	n1, n2, n3, n4 := graph.NewNode(1), graph.NewNode(2), graph.NewNode(3), graph.NewNode(4)
+	// 1 -> 2 -> 3
+	lhs := newGraph(t, graph.NewEdge(12, 1.0, n1, n2), graph.NewEdge(23, 2.0, n2, n3))
+	m2, m3 := graph.NewNode(2), graph.NewNode(3)
+	// 2 -> 3 -> 4
+	rhs := newGraph(t, graph.NewEdge(23, 2.0, m2, m3), graph.NewEdge(34, 3.0, m3, n4))
+
+	t.Run("transpose", func(t *testing.T) {
+		transposed, err := graph.TransposeWeighted(lhs)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, transposed, map[int][]int{1: {}, 2: {1}, 3: {2}})
+		e, ok := transposed.FindEdge(3, 2)
+		if !ok || e.Key() != 23 || e.Weight() != 2.0 {
+			t.Fatal("edge key and weight must be preserved")
+		}
+	})
+
+	t.Run("induced subgraph", func(t *testing.T) {
+		subgraph, err := graph.InducedWeightedSubgraph(lhs, []int{2, 3})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, subgraph, map[int][]int{2: {3}, 3: {}})
+		if len(subgraph.Edges()) != 1 {
+			t.Fatal("single edge must be kept")
+		}
+	})
+
+	t.Run("edge subgraph", func(t *testing.T) {
+		subgraph, err := graph.EdgeSubgraph(lhs, []int{12})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, subgraph, map[int][]int{1: {2}, 2: {}})
+
+		if _, err = graph.EdgeSubgraph(lhs, []int{34}); err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+
+	t.Run("union", func(t *testing.T) {
+		union, err := graph.UnionWeighted(lhs, rhs)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, union, map[int][]int{1: {2}, 2: {3}, 3: {4}, 4: {}})
+		if len(union.Edges()) != 3 {
+			t.Fatal("common edge must be merged")
+		}
+	})
+
+	t.Run("union with conflict", func(t *testing.T) {
+		m1, m2 := graph.NewNode(1), graph.NewNode(2)
+		conflicting := newGraph(t, graph.NewEdge(12, 5.0, m1, m2))
+		if _, err := graph.UnionWeighted(lhs, conflicting); err == nil {
+			t.Fatal("err must be not nil")
+		}
+
+		m1, m2 = graph.NewNode(1), graph.NewNode(2)
+		parallel := newGraph(t, graph.NewEdge(21, 1.0, m1, m2))
+		if _, err := graph.UnionWeighted(lhs, parallel); err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+
+	t.Run("intersection", func(t *testing.T) {
+		intersection, err := graph.IntersectionWeighted(lhs, rhs)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		checkLinks(t, intersection, map[int][]int{2: {3}, 3: {}})
+		if e, ok := intersection.Edge(23); !ok || e.Weight() != 2.0 {
+			t.Fatal("common edge must be kept")
+		}
+	})
+}
+
+func TestUndirectedGraphOperations(t *testing.T) {
+	count := 0
+	edgeKeyGen := func() int {
+		count++
+		return count
+	}
+	// 1 -- 2 -- 3
+	dependencies := map[int][]graph.Length[int, float64]{1: {graph.NewLength(2, 1.0)}, 2: {graph.NewLength(3, 2.0)}}
+	undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(dependencies, edgeKeyGen))
+	if err != nil {
+		t.Fatal("err must be nil")
+	}
+
+	t.Run("unweighted undirected graph", func(t *testing.T) {
+		transposed, err := graph.Transpose[int](undirectedGraph)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if _, ok := transposed.(graph.UndirectedGraph[int]); !ok {
+			t.Fatal("result must be undirected")
+		}
+		checkLinks(t, transposed, map[int][]int{1: {2}, 2: {1, 3}, 3: {2}})
+	})
+
+	t.Run("undirectedness is preserved", func(t *testing.T) {
+		e12, _ := undirectedGraph.FindEdge(1, 2)
+		operations := map[string]func() (graph.WeightedGraph[int, int, float64], error){
+			"transpose": func() (graph.WeightedGraph[int, int, float64], error) {
+				return graph.TransposeWeighted[int, int, float64](undirectedGraph)
+			},
+			"induced subgraph": func() (graph.WeightedGraph[int, int, float64], error) {
+				return graph.InducedWeightedSubgraph[int, int, float64](undirectedGraph, []int{1, 2})
+			},
+			"edge subgraph": func() (graph.WeightedGraph[int, int, float64], error) {
+				return graph.EdgeSubgraph[int, int, float64](undirectedGraph, []int{e12.Key()})
+			},
+			"union": func() (graph.WeightedGraph[int, int, float64], error) {
+				return graph.UnionWeighted[int, int, float64](undirectedGraph, undirectedGraph)
+			},
+			"intersection": func() (graph.WeightedGraph[int, int, float64], error) {
+				return graph.IntersectionWeighted[int, int, float64](undirectedGraph, undirectedGraph)
+			},
+		}
+		for name, operation := range operations {
+			res, err := operation()
+			if err != nil {
+				t.Fatalf("err of %s must be nil", name)
+			}
+			if _, ok := res.(graph.UndirectedWeightedGraph[int, int, float64]); !ok {
+				t.Fatalf("result of %s must be undirected", name)
+			}
+			if e, ok := res.FindEdge(2, 1); !ok || e.Key() != e12.Key() || e.Weight() != 1.0 {
+				t.Fatalf("edge must be kept in both directions by %s", name)
+			}
+		}
+	})
+
+	t.Run("directed and undirected graphs", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		directedGraph, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{graph.NewEdge(12, 1.0, n1, n2)})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		var mismatchErr *graph.DirectionMismatchError
+		if _, err = graph.UnionWeighted[int, int, float64](directedGraph, undirectedGraph); !errors.Is(err, graph.ErrDirectionMismatch) || !errors.As(err, &mismatchErr) {
+			t.Fatal("err must be direction mismatch error")
+		}
+		if _, err = graph.IntersectionWeighted[int, int, float64](undirectedGraph, directedGraph); !errors.Is(err, graph.ErrDirectionMismatch) {
+			t.Fatal("err must be direction mismatch error")
+		}
+	})
+}
+
+func checkLinks(t *testing.T, g graph.DirectedGraph[int], expected map[int][]int) {
+	if len(g.Nodes()) != len(expected) {
+		t.Fatal("inconsistent nodes amount")
+	}
+	for key, expectedChildren := range expected {
+		n, ok := g.Node(key)
+		if !ok {
+			t.Fatal("key must exist")
+		}
+		children := make([]int, 0)
+		for _, child := range n.Children() {
+			children = append(children, child.Key())
+		}
+		sort.Ints(children)
+		if len(children) != len(expectedChildren) {
+			t.Fatalf("inconsistent children of %v", key)
+		}
+		for i := range children {
+			if children[i] != expectedChildren[i] {
+				t.Fatalf("inconsistent children of %v", key)
+			}
+		}
+	}
+}
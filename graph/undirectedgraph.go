@@ -0,0 +1,75 @@
+package graph
+
+// UndirectedGraph keeps every link in both directions: children of node are its neighbors.
+type UndirectedGraph[T comparable] interface {
+	DirectedGraph[T]
+
+	Neighbors(T) []Node[T]
+}
+
+func NewUndirectedGraphFromCreator[T comparable](creator UndirectedGraphCreator[T]) (UndirectedGraph[T], error) {
+	nodesMap, linked := make(map[T]Node[T]), make(map[path[T]]struct{})
+	getNode := func(key T) Node[T] {
+		if _, ok := nodesMap[key]; !ok {
+			nodesMap[key] = NewNodeWithAttributes(key, creator.attributes[key])
+		}
+		return nodesMap[key]
+	}
+
+	for nodeKey, neighborsKeys := range creator.structure {
+		currentNode := getNode(nodeKey)
+		for _, neighborKey := range neighborsKeys {
+			if _, ok := linked[newPath(nodeKey, neighborKey)]; ok {
+				continue
+			}
+			neighborNode := getNode(neighborKey)
+			linked[newPath(nodeKey, neighborKey)], linked[newPath(neighborKey, nodeKey)] = struct{}{}, struct{}{}
+			currentNode.AddChildren(neighborNode)
+			if neighborNode != currentNode {
+				neighborNode.AddChildren(currentNode)
+			}
+		}
+	}
+
+	nodes := make([]Node[T], 0)
+	for _, n := range nodesMap {
+		nodes = append(nodes, n)
+	}
+
+	return NewUndirectedGraph(nodes...)
+}
+
+func NewUndirectedGraph[T comparable](ns ...Node[T]) (UndirectedGraph[T], error) {
+	graph, err := NewDirectedGraph(ns...)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make(map[path[T]]struct{})
+	for _, n := range graph.Nodes() {
+		for _, child := range n.Children() {
+			links[newPath(n.Key(), child.Key())] = struct{}{}
+		}
+	}
+	for link := range links {
+		if _, ok := links[newPath(link.to, link.from)]; !ok {
+			return nil, &MissingEdgeError{From: link.to, To: link.from, Link: true}
+		}
+	}
+
+	return &undirectedGraph[T]{DirectedGraph: graph}, nil
+}
+
+type undirectedGraph[T comparable] struct {
+	DirectedGraph[T]
+}
+
+func (g *undirectedGraph[T]) Neighbors(key T) []Node[T] {
+	n, ok := g.Node(key)
+	if !ok {
+		return nil
+	}
+	neighbors := make([]Node[T], len(n.Children()))
+	copy(neighbors, n.Children())
+	return neighbors
+}
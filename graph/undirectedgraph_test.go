@@ -0,0 +1,68 @@
+package graph_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestUndirectedGraph(t *testing.T) {
+	t.Run("test creator", func(t *testing.T) {
+		// links are listed once or in both directions
+		creator := graph.NewUndirectedGraphCreator(map[int][]int{1: {2, 3}, 2: {1}, 3: {}})
+		undirectedGraph, err := graph.NewUndirectedGraphFromCreator(creator)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		n1, ok := undirectedGraph.Node(1)
+		if !ok {
+			t.Fatal("key must exist")
+		}
+		n2, ok := undirectedGraph.Node(2)
+		if !ok {
+			t.Fatal("key must exist")
+		}
+		n3, ok := undirectedGraph.Node(3)
+		if !ok {
+			t.Fatal("key must exist")
+		}
+
+		neighbors := undirectedGraph.Neighbors(1)
+		sort.SliceStable(neighbors, func(i, j int) bool { return neighbors[i].Key() < neighbors[j].Key() })
+		if len(neighbors) != 2 || neighbors[0] != n2 || neighbors[1] != n3 {
+			t.Fatal("n1 must have n2 and n3 as neighbors")
+		}
+		if len(undirectedGraph.Neighbors(2)) != 1 || len(undirectedGraph.Neighbors(3)) != 1 {
+			t.Fatal("n2 and n3 must have single neighbor")
+		}
+		if undirectedGraph.Neighbors(2)[0] != n1 || undirectedGraph.Neighbors(3)[0] != n1 {
+			t.Fatal("n1 must be neighbor of n2 and n3")
+		}
+	})
+
+	t.Run("simple graph", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		n2.AddChildren(n1)
+
+		undirectedGraph, err := graph.NewUndirectedGraph(n1)
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if len(undirectedGraph.Nodes()) != 2 {
+			t.Fatal("graph must have 2 nodes")
+		}
+	})
+
+	t.Run("graph without reverse link", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+
+		_, err := graph.NewUndirectedGraph(n1)
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package graph
+
+// UndirectedWeightedGraph keeps single edge for every pair of neighbors. Edges returns each edge once
+// in orientation it was created with, while FindEdge and OutEdges orient it from the requested node.
+type UndirectedWeightedGraph[K, T comparable, W Weight] interface {
+	WeightedGraph[K, T, W]
+
+	Neighbors(T) []Node[T]
+}
+
+func NewUndirectedWeightedGraphFromCreator[K, T comparable, W Weight](creator UndirectedWeightedGraphCreator[K, T, W]) (UndirectedWeightedGraph[K, T, W], error) {
+	nodesMap, edgesPaths := make(map[T]Node[T]), make(map[path[T]]Edge[K, T, W])
+
+	getNode := func(key T) Node[T] {
+		if _, ok := nodesMap[key]; !ok {
+			nodesMap[key] = NewNodeWithAttributes(key, creator.attributes[key])
+		}
+		return nodesMap[key]
+	}
+
+	edges := make([]Edge[K, T, W], 0)
+	for nodeKey, neighborsSettings := range creator.structure {
+		currentNode := getNode(nodeKey)
+		for _, settings := range neighborsSettings {
+			if e, ok := edgesPaths[newPath(nodeKey, settings.to)]; ok {
+				if e.Weight() != settings.weight {
+					return nil, &DuplicateKeyError{Kind: KindPath, From: nodeKey, To: settings.to}
+				}
+				continue
+			}
+			neighborNode := getNode(settings.to)
+			currentNode.AddChildren(neighborNode)
+			if neighborNode != currentNode {
+				neighborNode.AddChildren(currentNode)
+			}
+			e := NewEdgeWithAttributes(creator.uniqueKGen(), settings.weight, settings.attributes, currentNode, neighborNode)
+			edgesPaths[newPath(nodeKey, settings.to)], edgesPaths[newPath(settings.to, nodeKey)] = e, e
+			edges = append(edges, e)
+		}
+	}
+
+	nodes := make([]Node[T], 0)
+	for _, n := range nodesMap {
+		nodes = append(nodes, n)
+	}
+	return NewUndirectedWeightedGraph(nodes, edges)
+}
+
+func NewUndirectedWeightedGraph[K, T comparable, W Weight](ns []Node[T], edges []Edge[K, T, W]) (UndirectedWeightedGraph[K, T, W], error) {
+	graph, err := NewUndirectedGraph(ns...)
+	if err != nil {
+		return nil, err
+	}
+
+	requiredWeights := make(map[path[T]]struct{})
+	for _, n := range graph.Nodes() {
+		for _, child := range n.Children() {
+			requiredWeights[newPath(n.Key(), child.Key())] = struct{}{}
+		}
+	}
+
+	edgesPaths, edgesMap := make(map[path[T]][]Edge[K, T, W]), make(map[K]Edge[K, T, W])
+	outEdges, inEdges := make(map[T][]Edge[K, T, W]), make(map[T][]Edge[K, T, W])
+	for _, e := range edges {
+		if _, ok := edgesMap[e.Key()]; ok {
+			return nil, &DuplicateKeyError{Kind: KindEdge, Key: e.Key()}
+		}
+		from, to := e.From().Key(), e.To().Key()
+		if _, ok := requiredWeights[newPath(from, to)]; !ok {
+			return nil, &MissingEdgeError{From: from, To: to, Link: true}
+		}
+		if _, ok := edgesPaths[newPath(from, to)]; ok {
+			return nil, &DuplicateKeyError{Kind: KindPath, From: from, To: to}
+		}
+		edgesMap[e.Key()], edgesPaths[newPath(from, to)] = e, []Edge[K, T, W]{e}
+		outEdges[from], inEdges[to] = append(outEdges[from], e), append(inEdges[to], e)
+		if from != to {
+			reversed := &edge[K, T, W]{key: e.Key(), weight: e.Weight(), attributes: e.Attributes(), from: e.To(), to: e.From()}
+			edgesPaths[newPath(to, from)] = []Edge[K, T, W]{reversed}
+			outEdges[to], inEdges[from] = append(outEdges[to], reversed), append(inEdges[from], reversed)
+		}
+	}
+
+	for requiredWeight := range requiredWeights {
+		if _, ok := edgesPaths[requiredWeight]; !ok {
+			return nil, &MissingEdgeError{From: requiredWeight.from, To: requiredWeight.to}
+		}
+	}
+
+	return &undirectedWeightedGraph[K, T, W]{
+		weightedGraph: weightedGraph[K, T, W]{
+			DirectedGraph: graph,
+			edgesPaths:    edgesPaths,
+			edgesMap:      edgesMap,
+			outEdges:      outEdges,
+			inEdges:       inEdges,
+		},
+		neighbors: graph,
+	}, nil
+}
+
+type undirectedWeightedGraph[K, T comparable, W Weight] struct {
+	weightedGraph[K, T, W]
+
+	neighbors UndirectedGraph[T]
+}
+
+func (w *undirectedWeightedGraph[K, T, W]) Neighbors(key T) []Node[T] {
+	return w.neighbors.Neighbors(key)
+}
@@ -0,0 +1,109 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestUndirectedWeightedGraph(t *testing.T) {
+	t.Run("test creator", func(t *testing.T) {
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 2.0), graph.NewLength(3, 3.0)},
+			2: {graph.NewLength(1, 2.0)},
+			3: {},
+		}
+		count := 0
+		uniqueKGen := func() int {
+			count++
+			return count
+		}
+		undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(dependencies, uniqueKGen))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		if len(undirectedGraph.Edges()) != 2 {
+			t.Fatal("each edge must be stored once")
+		}
+
+		e12, ok := undirectedGraph.FindEdge(1, 2)
+		if !ok {
+			t.Fatal("edge must exist")
+		}
+		e21, ok := undirectedGraph.FindEdge(2, 1)
+		if !ok {
+			t.Fatal("edge must exist")
+		}
+		if e12.Key() != e21.Key() || e12.Weight() != 2.0 || e21.Weight() != 2.0 {
+			t.Fatal("edge must be symmetric")
+		}
+		if e21.From().Key() != 2 || e21.To().Key() != 1 {
+			t.Fatal("edge must be oriented from requested node")
+		}
+
+		outEdges := undirectedGraph.OutEdges(3)
+		if len(outEdges) != 1 || outEdges[0].From().Key() != 3 || outEdges[0].To().Key() != 1 {
+			t.Fatal("edge must be oriented from requested node")
+		}
+	})
+
+	t.Run("simple graph", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		n2.AddChildren(n1)
+
+		e := graph.NewEdge(1, 2.0, n1, n2)
+
+		undirectedGraph, err := graph.NewUndirectedWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+		if edge, ok := undirectedGraph.Edge(1); !ok || edge != e {
+			t.Fatal("must be equal")
+		}
+	})
+
+	t.Run("test creator with conflicting weights", func(t *testing.T) {
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 2.0)},
+			2: {graph.NewLength(1, 3.0)},
+		}
+		count := 0
+		uniqueKGen := func() int {
+			count++
+			return count
+		}
+		_, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(dependencies, uniqueKGen))
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+
+	t.Run("graph with repeated edge", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+		n2.AddChildren(n1)
+
+		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(2, 2.0, n2, n1)
+
+		_, err := graph.NewUndirectedWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+
+	t.Run("graph with missing edge", func(t *testing.T) {
+		n1, n2, n3 := graph.NewNode(1), graph.NewNode(2), graph.NewNode(3)
+		n1.AddChildren(n2, n3)
+		n2.AddChildren(n1)
+		n3.AddChildren(n1)
+
+		e := graph.NewEdge(1, 2.0, n1, n2)
+
+		_, err := graph.NewUndirectedWeightedGraph([]graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int, float64]{e})
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package graph
+
+import "math"
+
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Infinity returns positive infinity for floating point weights and the largest value for integer ones.
+func Infinity[W Weight]() W {
+	if one := W(1); one/2 != 0 {
+		return W(math.Inf(1))
+	}
+	res := W(1)
+	for next := res*2 + 1; next > res; next = res*2 + 1 {
+		res = next
+	}
+	return res
+}
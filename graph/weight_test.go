@@ -0,0 +1,25 @@
+package graph_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func TestInfinity(t *testing.T) {
+	if graph.Infinity[float64]() != math.Inf(1) || graph.Infinity[float32]() != float32(math.Inf(1)) {
+		t.Fatal("infinity of floating point weight must be positive infinity")
+	}
+	if graph.Infinity[int]() != math.MaxInt || graph.Infinity[int8]() != math.MaxInt8 {
+		t.Fatal("infinity of integer weight must be the largest value")
+	}
+	if graph.Infinity[uint64]() != math.MaxUint64 || graph.Infinity[uint8]() != math.MaxUint8 {
+		t.Fatal("infinity of unsigned weight must be the largest value")
+	}
+
+	type cents int64
+	if graph.Infinity[cents]() != math.MaxInt64 {
+		t.Fatal("infinity of named weight must be the largest value")
+	}
+}
@@ -1,51 +1,80 @@
 package graph
 
-import (
-	"errors"
-	"fmt"
-)
-
-type WeightedGraph[K, T comparable] interface {
+type WeightedGraph[K, T comparable, W Weight] interface {
 	DirectedGraph[T]
 
-	Edge(K) (Edge[K, T], bool)
-	FindEdge(from, to T) (Edge[K, T], bool)
-	Edges() []Edge[K, T]
+	Edge(K) (Edge[K, T, W], bool)
+	FindEdge(from, to T) (Edge[K, T, W], bool)
+	FindEdges(from, to T) []Edge[K, T, W]
+	Edges() []Edge[K, T, W]
+	OutEdges(T) []Edge[K, T, W]
+	InEdges(T) []Edge[K, T, W]
+}
+
+func NewWeightedGraphFromCreator[K, T comparable, W Weight](creator WeightedGraphCreator[K, T, W]) (WeightedGraph[K, T, W], error) {
+	nodes, edges := fromWeightedCreator(creator)
+	return NewWeightedGraph(nodes, edges)
+}
+
+// NewWeightedMultigraphFromCreator allows the same child to be listed several times, each listing creates parallel edge.
+func NewWeightedMultigraphFromCreator[K, T comparable, W Weight](creator WeightedGraphCreator[K, T, W]) (WeightedGraph[K, T, W], error) {
+	nodes, edges := fromWeightedCreator(creator)
+	return NewWeightedMultigraph(nodes, edges)
 }
 
-func NewWeightedGraphFromCreator[K, T comparable](creator WeightedGraphCreator[K, T]) (WeightedGraph[K, T], error) {
-	nodesMap, edgesMap := make(map[T]Node[T]), make(map[K]Edge[K, T])
+func fromWeightedCreator[K, T comparable, W Weight](creator WeightedGraphCreator[K, T, W]) ([]Node[T], []Edge[K, T, W]) {
+	nodesMap, edgesMap := make(map[T]Node[T]), make(map[K]Edge[K, T, W])
 
 	getNode := func(key T) Node[T] {
 		if _, ok := nodesMap[key]; !ok {
-			nodesMap[key] = NewNode(key)
+			nodesMap[key] = NewNodeWithAttributes(key, creator.attributes[key])
 		}
 		return nodesMap[key]
 	}
 
 	for nodeKey, childrenSettings := range creator.structure {
 		children, currentNode := make([]Node[T], 0), getNode(nodeKey)
+		added := make(map[T]struct{})
 		for _, settings := range childrenSettings {
 			childNode := getNode(settings.to)
-			children = append(children, childNode)
-			e := NewEdge(creator.uniqueKGen(), settings.weight, currentNode, childNode)
+			if _, ok := added[settings.to]; !ok {
+				children, added[settings.to] = append(children, childNode), struct{}{}
+			}
+			e := NewEdgeWithAttributes(creator.uniqueKGen(), settings.weight, settings.attributes, currentNode, childNode)
 			edgesMap[e.Key()] = e
 		}
 		currentNode.AddChildren(children...)
 		nodesMap[currentNode.Key()] = currentNode
 	}
 
-	nodes, edges := make([]Node[T], 0), make([]Edge[K, T], 0)
+	nodes, edges := make([]Node[T], 0), make([]Edge[K, T, W], 0)
 	for _, n := range nodesMap {
 		nodes = append(nodes, n)
 	}
 	for _, e := range edgesMap {
 		edges = append(edges, e)
 	}
-	return NewWeightedGraph(nodes, edges)
+	return nodes, edges
+}
+
+func NewWeightedGraph[K, T comparable, W Weight](ns []Node[T], edges []Edge[K, T, W]) (WeightedGraph[K, T, W], error) {
+	graph, err := newWeightedGraph(ns, edges, false)
+	if err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// NewWeightedMultigraph allows parallel edges: every child link must be covered by at least one edge.
+func NewWeightedMultigraph[K, T comparable, W Weight](ns []Node[T], edges []Edge[K, T, W]) (WeightedGraph[K, T, W], error) {
+	graph, err := newWeightedGraph(ns, edges, true)
+	if err != nil {
+		return nil, err
+	}
+	return graph, nil
 }
 
-func NewWeightedGraph[K, T comparable](ns []Node[T], edges []Edge[K, T]) (WeightedGraph[K, T], error) {
+func newWeightedGraph[K, T comparable, W Weight](ns []Node[T], edges []Edge[K, T, W], multi bool) (*weightedGraph[K, T, W], error) {
 	graph, err := NewDirectedGraph[T](ns...)
 	if err != nil {
 		return nil, err
@@ -65,56 +94,99 @@ func NewWeightedGraph[K, T comparable](ns []Node[T], edges []Edge[K, T]) (Weight
 		}
 	}
 
-	actualWeights := make(map[path[T]]float64)
-	edgesPaths, edgesMap := make(map[path[T]]Edge[K, T]), make(map[K]Edge[K, T])
+	edgesPaths, edgesMap := make(map[path[T]][]Edge[K, T, W]), make(map[K]Edge[K, T, W])
+	outEdges, inEdges := make(map[T][]Edge[K, T, W]), make(map[T][]Edge[K, T, W])
 	for _, e := range edges {
 		if _, ok := edgesMap[e.Key()]; ok {
-			return nil, errors.New(fmt.Sprintf("repeated edge key %v", e.Key()))
+			return nil, &DuplicateKeyError{Kind: KindEdge, Key: e.Key()}
 		}
 		p := newPath(e.From().Key(), e.To().Key())
-		actualWeights[p], edgesPaths[p], edgesMap[e.Key()] = e.Weight(), e, e
-	}
-
-	for requiredWeight := range requiredWeights {
-		if _, ok := actualWeights[requiredWeight]; !ok {
-			return nil, errors.New(fmt.Sprintf("path from %v to %v is required", requiredWeight.from, requiredWeight.to))
+		if _, ok := requiredWeights[p]; !ok {
+			return nil, &MissingEdgeError{From: p.from, To: p.to, Link: true}
+		}
+		if _, ok := edgesPaths[p]; ok && !multi {
+			return nil, &DuplicateKeyError{Kind: KindPath, From: p.from, To: p.to}
 		}
+		edgesPaths[p], edgesMap[e.Key()] = append(edgesPaths[p], e), e
+		outEdges[p.from], inEdges[p.to] = append(outEdges[p.from], e), append(inEdges[p.to], e)
 	}
 
-	for actualWeight := range actualWeights {
-		if _, ok := requiredWeights[actualWeight]; !ok {
-			return nil, errors.New(fmt.Sprintf("weight from %v to %v is required", actualWeight.from, actualWeight.to))
+	for requiredWeight := range requiredWeights {
+		if _, ok := edgesPaths[requiredWeight]; !ok {
+			return nil, &MissingEdgeError{From: requiredWeight.from, To: requiredWeight.to}
 		}
 	}
 
-	return &weightedGraph[K, T]{
+	return &weightedGraph[K, T, W]{
 		DirectedGraph: graph,
 		edgesPaths:    edgesPaths,
 		edgesMap:      edgesMap,
+		outEdges:      outEdges,
+		inEdges:       inEdges,
+		multi:         multi,
 	}, nil
 }
 
-type weightedGraph[K, T comparable] struct {
+type weightedGraph[K, T comparable, W Weight] struct {
 	DirectedGraph[T]
 
-	edgesPaths map[path[T]]Edge[K, T]
-	edgesMap   map[K]Edge[K, T]
+	edgesPaths map[path[T]][]Edge[K, T, W]
+	edgesMap   map[K]Edge[K, T, W]
+	outEdges   map[T][]Edge[K, T, W]
+	inEdges    map[T][]Edge[K, T, W]
+	multi      bool
 }
 
-func (w *weightedGraph[K, T]) Edge(key K) (Edge[K, T], bool) {
+func (w *weightedGraph[K, T, W]) multigraph() bool { return w.multi }
+
+func (w *weightedGraph[K, T, W]) Edge(key K) (Edge[K, T, W], bool) {
 	e, ok := w.edgesMap[key]
 	return e, ok
 }
 
-func (w *weightedGraph[K, T]) FindEdge(from, to T) (Edge[K, T], bool) {
-	e, ok := w.edgesPaths[newPath(from, to)]
-	return e, ok
+// FindEdge returns the lightest of parallel edges.
+func (w *weightedGraph[K, T, W]) FindEdge(from, to T) (Edge[K, T, W], bool) {
+	edges := w.edgesPaths[newPath(from, to)]
+	if len(edges) == 0 {
+		return nil, false
+	}
+	res := edges[0]
+	for _, e := range edges[1:] {
+		if e.Weight() < res.Weight() {
+			res = e
+		}
+	}
+	return res, true
 }
 
-func (w *weightedGraph[K, T]) Edges() []Edge[K, T] {
-	edges := make([]Edge[K, T], 0, len(w.edgesMap))
+func (w *weightedGraph[K, T, W]) FindEdges(from, to T) []Edge[K, T, W] {
+	edges := make([]Edge[K, T, W], len(w.edgesPaths[newPath(from, to)]))
+	copy(edges, w.edgesPaths[newPath(from, to)])
+	return edges
+}
+
+func (w *weightedGraph[K, T, W]) Edges() []Edge[K, T, W] {
+	edges := make([]Edge[K, T, W], 0, len(w.edgesMap))
 	for _, e := range w.edgesMap {
 		edges = append(edges, e)
 	}
 	return edges
 }
+
+func (w *weightedGraph[K, T, W]) OutEdges(key T) []Edge[K, T, W] {
+	edges := make([]Edge[K, T, W], len(w.outEdges[key]))
+	copy(edges, w.outEdges[key])
+	return edges
+}
+
+func (w *weightedGraph[K, T, W]) InEdges(key T) []Edge[K, T, W] {
+	edges := make([]Edge[K, T, W], len(w.inEdges[key]))
+	copy(edges, w.inEdges[key])
+	return edges
+}
+
+// InDegree counts edges, so parallel edges of multigraph are counted separately.
+func (w *weightedGraph[K, T, W]) InDegree(key T) int { return len(w.inEdges[key]) }
+
+// OutDegree counts edges, so parallel edges of multigraph are counted separately.
+func (w *weightedGraph[K, T, W]) OutDegree(key T) int { return len(w.outEdges[key]) }
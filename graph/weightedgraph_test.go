@@ -9,7 +9,7 @@ import (
 
 func TestWeightedGraph(t *testing.T) {
 	t.Run("test creator", func(t *testing.T) {
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 2.0), graph.NewLength(3, 3.0)},
 			2: {},
 			3: {},
@@ -49,7 +49,7 @@ func TestWeightedGraph(t *testing.T) {
 			t.Fatal("edge must exist")
 		}
 
-		validator(t, weightedGraph, []graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int]{e1, e2})
+		validator(t, weightedGraph, []graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int, float64]{e1, e2})
 	})
 
 	t.Run("simple graph", func(t *testing.T) {
@@ -59,12 +59,12 @@ func TestWeightedGraph(t *testing.T) {
 
 		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(2, 3.0, n1, n3)
 
-		weightedGraph, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int]{e1, e2})
+		weightedGraph, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int, float64]{e1, e2})
 		if err != nil {
 			t.Fatal("err must be nil")
 		}
 
-		validator(t, weightedGraph, []graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int]{e1, e2})
+		validator(t, weightedGraph, []graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int, float64]{e1, e2})
 	})
 
 	t.Run("graph with circular dependencies", func(t *testing.T) {
@@ -74,12 +74,12 @@ func TestWeightedGraph(t *testing.T) {
 
 		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(2, 3.0, n2, n1)
 
-		weightedGraph, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int]{e1, e2})
+		weightedGraph, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
 		if err != nil {
 			t.Fatal("err must be nil")
 		}
 
-		validator(t, weightedGraph, []graph.Node[int]{n1, n2}, []graph.Edge[int, int]{e1, e2})
+		validator(t, weightedGraph, []graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
 	})
 
 	t.Run("graph with repeated node's key", func(t *testing.T) {
@@ -89,7 +89,7 @@ func TestWeightedGraph(t *testing.T) {
 
 		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(2, 3.0, n2, n1)
 
-		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int]{e1, e2})
+		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
 		if err == nil {
 			t.Fatal("err must be not nil")
 		}
@@ -102,7 +102,7 @@ func TestWeightedGraph(t *testing.T) {
 
 		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(1, 3.0, n2, n1)
 
-		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int]{e1, e2})
+		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
 		if err == nil {
 			t.Fatal("err must be not nil")
 		}
@@ -116,7 +116,7 @@ func TestWeightedGraph(t *testing.T) {
 
 		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(2, 3.0, n1, n3)
 
-		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int]{e1, e2})
+		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int, float64]{e1, e2})
 		if err == nil {
 			t.Fatal("err must be not nil")
 		}
@@ -130,14 +130,90 @@ func TestWeightedGraph(t *testing.T) {
 		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(2, 3.0, n1, n3)
 		e3 := graph.NewEdge(3, 4.0, n2, n3)
 
-		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int]{e1, e2, e3})
+		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2, n3}, []graph.Edge[int, int, float64]{e1, e2, e3})
 		if err == nil {
 			t.Fatal("err must be not nil")
 		}
 	})
+	t.Run("multigraph creator", func(t *testing.T) {
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 2.0), graph.NewLength(2, 3.0)},
+			2: {},
+		}
+		count := 0
+		uniqueKGen := func() int {
+			count++
+			return count
+		}
+		_, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, uniqueKGen))
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+
+		multigraph, err := graph.NewWeightedMultigraphFromCreator(graph.NewWeightedGraphCreator(dependencies, uniqueKGen))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		n1, _ := multigraph.Node(1)
+		if len(n1.Children()) != 1 {
+			t.Fatal("n1 must have single child")
+		}
+		if len(multigraph.FindEdges(1, 2)) != 2 || len(multigraph.Edges()) != 2 || len(multigraph.OutEdges(1)) != 2 {
+			t.Fatal("parallel edges must be kept")
+		}
+		if e, ok := multigraph.FindEdge(1, 2); !ok || e.Weight() != 2.0 {
+			t.Fatal("the lightest edge must be found")
+		}
+	})
+
+	t.Run("multigraph with parallel edges", func(t *testing.T) {
+		n1, n2 := graph.NewNode(1), graph.NewNode(2)
+		n1.AddChildren(n2)
+
+		e1, e2 := graph.NewEdge(1, 2.0, n1, n2), graph.NewEdge(2, 3.0, n1, n2)
+
+		_, err := graph.NewWeightedGraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+
+		multigraph, err := graph.NewWeightedMultigraph([]graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		validator(t, multigraph, []graph.Node[int]{n1, n2}, []graph.Edge[int, int, float64]{e1, e2})
+	})
+	t.Run("in edges and degrees", func(t *testing.T) {
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 2.0), graph.NewLength(2, 3.0)},
+			2: {},
+		}
+		count := 0
+		uniqueKGen := func() int {
+			count++
+			return count
+		}
+		multigraph, err := graph.NewWeightedMultigraphFromCreator(graph.NewWeightedGraphCreator(dependencies, uniqueKGen))
+		if err != nil {
+			t.Fatal("err must be nil")
+		}
+
+		inEdges := multigraph.InEdges(2)
+		if len(inEdges) != 2 || inEdges[0].To().Key() != 2 || inEdges[1].To().Key() != 2 {
+			t.Fatal("parallel edges must be incoming to n2")
+		}
+		if len(multigraph.Parents(2)) != 1 {
+			t.Fatal("n1 must be single parent of n2")
+		}
+		if multigraph.InDegree(2) != 2 || multigraph.OutDegree(1) != 2 || multigraph.InDegree(1) != 0 {
+			t.Fatal("degree must count parallel edges")
+		}
+	})
 }
 
-func validator(t *testing.T, graph graph.WeightedGraph[int, int], expectedNodes []graph.Node[int], expectedEdges []graph.Edge[int, int]) {
+func validator(t *testing.T, graph graph.WeightedGraph[int, int, float64], expectedNodes []graph.Node[int], expectedEdges []graph.Edge[int, int, float64]) {
 	nodes := graph.Nodes()
 	sort.SliceStable(nodes, func(i, j int) bool { return nodes[i].Key() < nodes[j].Key() })
 	sort.SliceStable(expectedNodes, func(i, j int) bool { return expectedNodes[i].Key() < expectedNodes[j].Key() })
@@ -0,0 +1,62 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func newAllShortestPaths[K, T comparable, W graph.Weight](nodes []graph.Node[T]) *AllShortestPaths[K, T, W] {
+	a := &AllShortestPaths[K, T, W]{
+		distances: make(map[T]map[T]W, len(nodes)),
+		next:      make(map[T]map[T]graph.Edge[K, T, W], len(nodes)),
+	}
+	for _, n := range nodes {
+		a.distances[n.Key()] = map[T]W{n.Key(): 0}
+		a.next[n.Key()] = make(map[T]graph.Edge[K, T, W])
+	}
+	return a
+}
+
+type AllShortestPaths[K, T comparable, W graph.Weight] struct {
+	distances map[T]map[T]W
+	next      map[T]map[T]graph.Edge[K, T, W]
+}
+
+func (a *AllShortestPaths[K, T, W]) Distance(from, to T) (W, bool) {
+	d, ok := a.distances[from][to]
+	return d, ok
+}
+
+func (a *AllShortestPaths[K, T, W]) Distances() map[T]map[T]W {
+	distances := make(map[T]map[T]W, len(a.distances))
+	for from, row := range a.distances {
+		distances[from] = make(map[T]W, len(row))
+		for to, d := range row {
+			distances[from][to] = d
+		}
+	}
+	return distances
+}
+
+func (a *AllShortestPaths[K, T, W]) Next(from, to T) (graph.Edge[K, T, W], bool) {
+	e, ok := a.next[from][to]
+	return e, ok
+}
+
+func (a *AllShortestPaths[K, T, W]) Path(from, to T) ([]graph.Edge[K, T, W], W, error) {
+	for _, key := range []T{from, to} {
+		if _, ok := a.distances[key]; !ok {
+			return nil, 0, &graph.UnknownNodeError{Key: key}
+		}
+	}
+	d, ok := a.distances[from][to]
+	if !ok {
+		return nil, 0, &NoPathError{From: from, To: to}
+	}
+	res := make([]graph.Edge[K, T, W], 0)
+	for current := from; current != to; {
+		e := a.next[current][to]
+		res = append(res, e)
+		current = e.To().Key()
+	}
+	return res, d, nil
+}
@@ -0,0 +1,82 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+const maxCountedNodes = 20
+
+func AllTopologicalSorts[T comparable](directedGraph graph.DirectedGraph[T], yield func([]graph.Node[T]) bool) error {
+	if _, err := TopologicalSort(directedGraph); err != nil {
+		return err
+	}
+
+	nodes, inDegrees := directedGraph.Nodes(), toInDegrees(directedGraph)
+	current, used := make([]graph.Node[T], 0, len(nodes)), make(map[T]bool)
+
+	var backtrack func() bool
+	backtrack = func() bool {
+		if len(current) == len(nodes) {
+			res := make([]graph.Node[T], len(current))
+			copy(res, current)
+			return yield(res)
+		}
+		for _, n := range nodes {
+			if used[n.Key()] || inDegrees[n.Key()] != 0 {
+				continue
+			}
+			used[n.Key()], current = true, append(current, n)
+			for _, child := range n.Children() {
+				inDegrees[child.Key()]--
+			}
+			next := backtrack()
+			for _, child := range n.Children() {
+				inDegrees[child.Key()]++
+			}
+			used[n.Key()], current = false, current[:len(current)-1]
+			if !next {
+				return false
+			}
+		}
+		return true
+	}
+	backtrack()
+	return nil
+}
+
+func CountTopologicalSorts[T comparable](directedGraph graph.DirectedGraph[T]) (uint64, error) {
+	nodes := directedGraph.Nodes()
+	if len(nodes) > maxCountedNodes {
+		return 0, &TooManyNodesError{Count: len(nodes), Limit: maxCountedNodes}
+	}
+	if _, err := TopologicalSort(directedGraph); err != nil {
+		return 0, err
+	}
+
+	indexes := make(map[T]int, len(nodes))
+	for i, n := range nodes {
+		indexes[n.Key()] = i
+	}
+	// parents[i] is bit mask of nodes which must precede i-th node
+	parents := make([]uint32, len(nodes))
+	for _, n := range nodes {
+		for _, child := range n.Children() {
+			parents[indexes[child.Key()]] |= 1 << indexes[n.Key()]
+		}
+	}
+
+	// counts[mask] is amount of orderings of nodes from mask placed before all other nodes
+	counts := make([]uint64, 1<<len(nodes))
+	counts[0] = 1
+	for mask := range counts {
+		if counts[mask] == 0 {
+			continue
+		}
+		for i := range nodes {
+			if mask&(1<<i) == 0 && parents[i]&uint32(mask) == parents[i] {
+				counts[mask|1<<i] += counts[mask]
+			}
+		}
+	}
+	return counts[len(counts)-1], nil
+}
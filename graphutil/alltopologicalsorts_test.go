@@ -0,0 +1,82 @@
+package graphutil_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestAllTopologicalSorts(t *testing.T) {
+	t.Run("branched struct", func(t *testing.T) {
+		directedGraph, checker := branchedGraph()
+		found := make(map[string]struct{})
+		err := graphutil.AllTopologicalSorts(directedGraph, func(sortedSeq []graph.Node[string]) bool {
+			if !checker(sortedSeq) {
+				t.Fatal("inconsistent order")
+			}
+			found[strings.Join(nodeKeys(sortedSeq), " ")] = struct{}{}
+			return true
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != 3 {
+			t.Fatal("all 3 orderings must be found")
+		}
+	})
+
+	t.Run("early stop", func(t *testing.T) {
+		directedGraph, _ := branchedGraph()
+		calls := 0
+		err := graphutil.AllTopologicalSorts(directedGraph, func([]graph.Node[string]) bool {
+			calls++
+			return false
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if calls != 1 {
+			t.Fatal("enumeration must stop after first ordering")
+		}
+	})
+
+	t.Run("circled sequence", func(t *testing.T) {
+		err := graphutil.AllTopologicalSorts(generateLoopedGraph(), func([]graph.Node[string]) bool { return true })
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+}
+
+func TestCountTopologicalSorts(t *testing.T) {
+	t.Run("branched struct", func(t *testing.T) {
+		graph, _ := branchedGraph()
+		count, err := graphutil.CountTopologicalSorts(graph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 3 {
+			t.Fatalf("incorrect count %d", count)
+		}
+	})
+
+	t.Run("independent nodes", func(t *testing.T) {
+		dependencies := map[int][]int{1: {}, 2: {}, 3: {}, 4: {}, 5: {}}
+		directedGraph, _ := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(dependencies))
+		count, err := graphutil.CountTopologicalSorts(directedGraph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 120 {
+			t.Fatalf("incorrect count %d", count)
+		}
+	})
+
+	t.Run("circled sequence", func(t *testing.T) {
+		if _, err := graphutil.CountTopologicalSorts(generateLoopedGraph()); err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+}
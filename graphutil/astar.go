@@ -0,0 +1,77 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func AStar[K, T comparable, W graph.Weight](start, goal T, weightedGraph graph.WeightedGraph[K, T, W], h func(T) W, opts ...Option[K, T, W]) ([]graph.Edge[K, T, W], W, error) {
+	if err := checkNodes[T](weightedGraph, start, goal); err != nil {
+		return nil, 0, err
+	}
+	o := newOptions(opts)
+	if o.debug {
+		if err := checkAdmissible(goal, weightedGraph, h, o); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	res := newShortestPaths[K, T, W](start, weightedGraph)
+
+	q := newPriorityQueue[T, W]()
+	q.Push(start, h(start))
+	for !q.Empty() {
+		currentKey, f := q.Pop()
+		if f > add(res.distances[currentKey], h(currentKey)) {
+			continue
+		}
+		if currentKey == goal {
+			return res.PathTo(goal)
+		}
+		for _, e := range o.outEdges(weightedGraph)(currentKey) {
+			w := o.weight(e)
+			if w < 0 {
+				return nil, 0, &NegativeWeightError{Key: e.Key(), From: e.From().Key(), To: e.To().Key(), Weight: w}
+			}
+			if res.relax(e, w) {
+				q.Push(e.To().Key(), add(res.distances[e.To().Key()], h(e.To().Key())))
+			}
+		}
+	}
+
+	return nil, 0, &NoPathError{From: start, To: goal}
+}
+
+func CheckAdmissible[K, T comparable, W graph.Weight](goal T, weightedGraph graph.WeightedGraph[K, T, W], h func(T) W) error {
+	if err := checkNodes[T](weightedGraph, goal); err != nil {
+		return err
+	}
+	return checkAdmissible(goal, weightedGraph, h, newOptions[K, T, W](nil))
+}
+
+// checkAdmissible compares heuristic with distances to goal in graph of filtered edges.
+func checkAdmissible[K, T comparable, W graph.Weight](goal T, weightedGraph graph.WeightedGraph[K, T, W], h func(T) W, o *options[K, T, W]) error {
+	distances := map[T]W{goal: 0}
+	q := newPriorityQueue[T, W]()
+	q.Push(goal, 0)
+	for !q.Empty() {
+		currentKey, d := q.Pop()
+		if d > distances[currentKey] {
+			continue
+		}
+		if h(currentKey) > d {
+			return &InadmissibleHeuristicError{Node: currentKey, Goal: goal, Heuristic: h(currentKey), Distance: d}
+		}
+		for _, e := range o.filtered(weightedGraph.InEdges(currentKey)) {
+			w := o.weight(e)
+			if w < 0 {
+				return &NegativeWeightError{Key: e.Key(), From: e.From().Key(), To: e.To().Key(), Weight: w}
+			}
+			from := e.From().Key()
+			if fromDistance, ok := distances[from]; !ok || fromDistance > add(d, w) {
+				distances[from] = add(d, w)
+				q.Push(from, distances[from])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package graphutil_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+type cell struct {
+	x, y int
+}
+
+func TestAStar(t *testing.T) {
+	// 3x3 grid, moving right or down costs 1, center cell is expensive
+	dependencies := make(map[cell][]graph.Length[cell, float64])
+	for x := 0; x < 3; x++ {
+		for y := 0; y < 3; y++ {
+			from, lengths := cell{x, y}, make([]graph.Length[cell, float64], 0)
+			for _, to := range []cell{{x + 1, y}, {x, y + 1}} {
+				if to.x > 2 || to.y > 2 {
+					continue
+				}
+				weight := 1.0
+				if to == (cell{1, 1}) {
+					weight = 10
+				}
+				lengths = append(lengths, graph.NewLength(to, weight))
+			}
+			dependencies[from] = lengths
+		}
+	}
+	goal := cell{2, 2}
+	manhattan := func(c cell) float64 { return float64(goal.x - c.x + goal.y - c.y) }
+
+	t.Run("test grid graph", func(t *testing.T) {
+		edges, length, err := graphutil.AStar(cell{0, 0}, goal, newWeightedGraph(t, dependencies), manhattan)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if length != 4 || len(edges) != 4 {
+			t.Fatalf("incorrect length %v", length)
+		}
+		for _, e := range edges {
+			if e.To().Key() == (cell{1, 1}) {
+				t.Fatal("path must avoid center cell")
+			}
+		}
+	})
+
+	t.Run("test unreachable goal", func(t *testing.T) {
+		_, _, err := graphutil.AStar(goal, cell{0, 0}, newWeightedGraph(t, dependencies), manhattan)
+		if err == nil {
+			t.Fatal("error must not be nil")
+		}
+	})
+
+	t.Run("test inadmissible heuristic in debug mode", func(t *testing.T) {
+		overestimated := func(c cell) float64 { return 10 * manhattan(c) }
+		weightedGraph := newWeightedGraph(t, dependencies)
+
+		_, _, err := graphutil.AStar(cell{0, 0}, goal, weightedGraph, overestimated)
+		if err != nil {
+			t.Fatal("error must be nil without debug mode")
+		}
+
+		_, _, err = graphutil.AStar(cell{0, 0}, goal, weightedGraph, overestimated, graphutil.WithDebug[int, cell, float64]())
+		if err == nil {
+			t.Fatal("error must not be nil")
+		}
+	})
+
+	t.Run("test admissibility in filtered graph", func(t *testing.T) {
+		//    1
+		// 1 -> -> 3
+		// 5\     / 5
+		//   \-> 2
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(3, 1), graph.NewLength(2, 5)},
+			2: {graph.NewLength(3, 5)},
+			3: {},
+		}
+		h := func(key int) float64 { return map[int]float64{1: 8, 2: 5}[key] }
+		withoutShortcut := graphutil.WithEdgeFilter[int, int, float64](func(e graph.Edge[int, int, float64]) bool {
+			return e.From().Key() != 1 || e.To().Key() != 3
+		})
+
+		edges, length, err := graphutil.AStar(1, 3, newWeightedGraph(t, dependencies), h, withoutShortcut, graphutil.WithDebug[int, int, float64]())
+		if err != nil {
+			t.Fatal("heuristic is admissible for filtered graph")
+		}
+		if len(edges) != 2 || length != 10 {
+			t.Fatal("incorrect path")
+		}
+	})
+}
+
+// BenchmarkAStar searches close goal in large grid, heuristic cuts search space, so it must not depend on size of graph.
+func BenchmarkAStar(b *testing.B) {
+	for _, size := range []int{100, 400} {
+		dependencies := make(map[cell][]graph.Length[cell, float64], size*size)
+		for x := 0; x < size; x++ {
+			for y := 0; y < size; y++ {
+				lengths := make([]graph.Length[cell, float64], 0)
+				for _, to := range []cell{{x + 1, y}, {x, y + 1}} {
+					if to.x < size && to.y < size {
+						lengths = append(lengths, graph.NewLength(to, 1))
+					}
+				}
+				dependencies[cell{x, y}] = lengths
+			}
+		}
+		count := 0
+		weightedGraph, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, func() int { count++; return count }))
+		if err != nil {
+			b.Fatal("error must be nil")
+		}
+
+		goal := cell{5, 5}
+		h := func(c cell) float64 { return math.Abs(float64(goal.x-c.x)) + math.Abs(float64(goal.y-c.y)) }
+		b.Run(fmt.Sprintf("size %d", size*size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, _, err := graphutil.AStar(cell{0, 0}, goal, weightedGraph, h); err != nil {
+					b.Fatal("error must be nil")
+				}
+			}
+		})
+	}
+}
@@ -1,30 +1,40 @@
 package graphutil
 
 import (
-	"errors"
-
 	"github.com/brmatvey/go-graphs/graph"
 )
 
-func BellmanFord[K, T comparable](start T, weightedGraph graph.WeightedGraph[K, T]) (map[T]float64, error) {
-	nodes, edges := weightedGraph.Nodes(), weightedGraph.Edges()
-	res := make(map[T]float64)
-	for _, n := range nodes {
-		res[n.Key()] = max
+func BellmanFord[K, T comparable, W graph.Weight](start T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (map[T]W, error) {
+	paths, err := BellmanFordPaths(start, weightedGraph, opts...)
+	if err != nil {
+		return nil, err
 	}
-	res[start] = 0.0
+	return paths.lengths(), nil
+}
+
+func BellmanFordPaths[K, T comparable, W graph.Weight](start T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*ShortestPaths[K, T, W], error) {
+	if err := checkNodes[T](weightedGraph, start); err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+	nodes, edges := weightedGraph.Nodes(), o.arcs(weightedGraph)
+	res := newShortestPaths[K, T, W](start, weightedGraph)
 
 	for i := 0; i < len(nodes)-1; i++ {
+		relaxed := false
 		for _, e := range edges {
-			if res[e.To().Key()] > res[e.From().Key()]+e.Weight() {
-				res[e.To().Key()] = res[e.From().Key()] + e.Weight()
+			if res.relax(e, o.weight(e)) {
+				relaxed = true
 			}
 		}
+		if !relaxed {
+			break
+		}
 	}
 
 	for _, e := range edges {
-		if res[e.To().Key()] != max && res[e.To().Key()] > res[e.From().Key()]+e.Weight() {
-			return nil, errors.New("negative circular dependencies in graph")
+		if res.relax(e, o.weight(e)) {
+			return nil, newNegativeCycleError(e.To().Key(), res.edges, len(nodes), o.weight)
 		}
 	}
 
@@ -1,6 +1,7 @@
 package graphutil_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/brmatvey/go-graphs/graph"
@@ -11,7 +12,7 @@ func TestBellmanFord(t *testing.T) {
 	t.Run("test linear graph", func(t *testing.T) {
 		//   1    2    3
 		// 1 -> 2 -> 3 -> 4
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 1)},
 			2: {graph.NewLength(3, 2)},
 			3: {graph.NewLength(4, 3)},
@@ -45,7 +46,7 @@ func TestBellmanFord(t *testing.T) {
 		//   \->3 -> 5 ->/    /
 		//     2 \           / 3
 		//        \-> 6 -> ->
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 6), graph.NewLength(3, 1)},
 			2: {graph.NewLength(4, 7)},
 			3: {graph.NewLength(5, 3), graph.NewLength(6, 2)},
@@ -84,7 +85,7 @@ func TestBellmanFord(t *testing.T) {
 		//   \->3 -> 5 ->/    /
 		//     2 \           / 3
 		//        \-> 6 -> ->
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 6), graph.NewLength(3, 1)},
 			2: {graph.NewLength(4, 7)},
 			3: {graph.NewLength(5, 1), graph.NewLength(6, 2)},
@@ -117,7 +118,7 @@ func TestBellmanFord(t *testing.T) {
 	})
 
 	t.Run("test graph with negative circular dependencies", func(t *testing.T) {
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, -1)},
 			2: {graph.NewLength(1, -1)},
 		}
@@ -137,4 +138,95 @@ func TestBellmanFord(t *testing.T) {
 			t.Fatal("error must not be nil")
 		}
 	})
+
+	t.Run("test negative circle extraction", func(t *testing.T) {
+		//   1    -2
+		// 1 -> 2 -> 3
+		//       \<- /
+		//         1
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 1)},
+			2: {graph.NewLength(3, -2)},
+			3: {graph.NewLength(2, 1)},
+		}
+
+		_, err := graphutil.BellmanFord(1, newWeightedGraph(t, dependencies))
+		checkNegativeCycleError(t, err, -1, 2, 3)
+	})
+	t.Run("test multigraph", func(t *testing.T) {
+		//   5, 2    1
+		// 1 => 2 -> 3
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 5), graph.NewLength(2, 2)},
+			2: {graph.NewLength(3, 1)},
+		}
+		count := 0
+		edgeKeyGen := func() int {
+			count++
+			return count
+		}
+
+		weightedGraph, err := graph.NewWeightedMultigraphFromCreator(graph.NewWeightedGraphCreator(dependencies, edgeKeyGen))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		paths, err := graphutil.BellmanFordPaths(1, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		edges, length, err := paths.PathTo(3)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if length != 3 || edges[0].Weight() != 2 {
+			t.Fatal("lighter parallel edge must be used")
+		}
+	})
+	t.Run("test integer overflow", func(t *testing.T) {
+		//   100    100
+		// 1 -> 2 -> 3
+		dependencies := map[int][]graph.Length[int, int8]{
+			1: {graph.NewLengthOf[int, int8](2, 100)},
+			2: {graph.NewLengthOf[int, int8](3, 100)},
+			3: {},
+		}
+
+		lengths, err := graphutil.BellmanFord(1, newWeightedGraph(t, dependencies))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		if lengths[2] != 100 || lengths[3] != graph.Infinity[int8]() {
+			t.Fatal("length must saturate at infinity")
+		}
+	})
+
+	t.Run("test negative integer overflow", func(t *testing.T) {
+		//   -100   -100
+		// 1 -> 2 -> 3
+		dependencies := map[int][]graph.Length[int, int8]{
+			1: {graph.NewLengthOf[int, int8](2, -100)},
+			2: {graph.NewLengthOf[int, int8](3, -100)},
+			3: {},
+		}
+		weightedGraph := newWeightedGraph(t, dependencies)
+
+		lengths, err := graphutil.BellmanFord(1, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if lengths[2] != -100 || lengths[3] != math.MinInt8 {
+			t.Fatal("length must saturate at the least value")
+		}
+
+		all, err := graphutil.FloydWarshall(weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if d, ok := all.Distance(1, 3); !ok || d != math.MinInt8 {
+			t.Fatal("length must saturate at the least value")
+		}
+	})
 }
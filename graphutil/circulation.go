@@ -0,0 +1,111 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// Circulation is feasible flow satisfying bounds of edges and demands of nodes.
+type Circulation[K, T comparable, W graph.Weight] struct {
+	flows map[K]W
+	edges map[K]graph.Edge[K, T, W]
+}
+
+// EdgeFlow returns flow through edge, undirected edge passes it in direction of Edge(key).
+func (c *Circulation[K, T, W]) EdgeFlow(key K) W { return c.flows[key] }
+
+func (c *Circulation[K, T, W]) EdgeFlows() map[K]W {
+	flows := make(map[K]W, len(c.flows))
+	for key, value := range c.flows {
+		flows[key] = value
+	}
+	return flows
+}
+
+// Edge returns edge oriented along its flow.
+func (c *Circulation[K, T, W]) Edge(key K) (graph.Edge[K, T, W], bool) {
+	e, ok := c.edges[key]
+	return e, ok
+}
+
+// FeasibleCirculation finds flow which is between lower and upper bounds in every edge, demand of node is its inflow minus outflow:
+// positive demand is consumed by node and negative one is supplied. Nodes missing in demands are transit ones.
+// Lower bounds are removed by sending them in advance, then the rest of demands are satisfied by max flow from auxiliary source to auxiliary sink.
+// Edges with both bounds zero carry no flow, so bounds could exclude edges as well. InfeasibleCirculationError with violated cut is returned if there is no feasible flow,
+// ImbalanceError is returned if sum of demands isn't zero, BoundsError is returned if lower bound of some edge exceeds upper one,
+// LowerBoundError is returned if undirected edge has nonzero lower bound.
+func FeasibleCirculation[K, T comparable, W Signed](weightedGraph graph.WeightedGraph[K, T, W], lower, upper WeightFunc[K, T, W], demands map[T]W) (*Circulation[K, T, W], error) {
+	keys := make([]T, 0, len(demands))
+	for key := range demands {
+		keys = append(keys, key)
+	}
+	if err := checkNodes[T](weightedGraph, keys...); err != nil {
+		return nil, err
+	}
+	o := &options[K, T, W]{weight: func(e graph.Edge[K, T, W]) W { return upper(e) - lower(e) }}
+	for _, e := range o.arcs(weightedGraph) {
+		if l, u := lower(e), upper(e); l > u {
+			return nil, &BoundsError{Key: e.Key(), From: e.From().Key(), To: e.To().Key(), Lower: l, Upper: u}
+		}
+	}
+	n, err := newNetwork(weightedGraph, o)
+	if err != nil {
+		return nil, err
+	}
+
+	// demands which remain after lower bounds are sent
+	remaining, total := make([]W, len(n.keys)), W(0)
+	for i, key := range n.keys {
+		remaining[i], total = demands[key], total+demands[key]
+	}
+	if total != 0 {
+		return nil, &ImbalanceError[W]{Total: total}
+	}
+	_, undirected := weightedGraph.(graph.UndirectedWeightedGraph[K, T, W])
+	bounds := make([]W, len(n.edges))
+	for pair, e := range n.edges {
+		if bounds[pair] = lower(e); undirected && bounds[pair] != 0 {
+			return nil, &LowerBoundError{Key: e.Key(), From: e.From().Key(), To: e.To().Key(), Lower: bounds[pair]}
+		}
+		remaining[n.to[2*pair]] -= bounds[pair]
+		remaining[n.to[2*pair+1]] += bounds[pair]
+	}
+
+	pairs := len(n.edges)
+	source, sink := n.addNode(), n.addNode()
+	required := W(0)
+	for v, demand := range remaining {
+		if demand > 0 {
+			n.addArcs(v, sink, demand, nil)
+			required += demand
+		} else if demand < 0 {
+			n.addArcs(source, v, -demand, nil)
+		}
+	}
+	if dinic(n, source, sink) < required {
+		return nil, newInfeasibleCirculationError(n, n.sourceSide(source), pairs, lower, upper, demands)
+	}
+
+	c := &Circulation[K, T, W]{}
+	c.flows, c.edges = edgeFlows(n.edges[:pairs], func(pair int) W { return add(n.flow(pair), bounds[pair]) })
+	return c, nil
+}
+
+// newInfeasibleCirculationError returns nodes which aren't reachable from auxiliary source, they violate Hoffman's condition.
+func newInfeasibleCirculationError[K, T comparable, W Signed](n *network[K, T, W], side []bool, pairs int, lower, upper WeightFunc[K, T, W], demands map[T]W) error {
+	res := &InfeasibleCirculationError[K, T, W]{Nodes: make([]T, 0), Edges: make([]graph.Edge[K, T, W], 0)}
+	for i, key := range n.keys {
+		if !side[i] {
+			res.Nodes, res.Demand = append(res.Nodes, key), res.Demand+demands[key]
+		}
+	}
+	for pair, e := range n.edges[:pairs] {
+		from, to := side[n.to[2*pair+1]], side[n.to[2*pair]]
+		switch {
+		case from && !to:
+			res.Edges, res.Capacity = append(res.Edges, e), add(res.Capacity, upper(e))
+		case !from && to:
+			res.Edges, res.Capacity = append(res.Edges, e), res.Capacity-lower(e)
+		}
+	}
+	return res
+}
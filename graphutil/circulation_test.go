@@ -0,0 +1,131 @@
+package graphutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestFeasibleCirculation(t *testing.T) {
+	bounds := func(lower, upper float64) graph.Attributes { return graph.Attributes{"lower": lower, "upper": upper} }
+	lower, upper := graphutil.AttributeWeight[int, int, float64]("lower"), graphutil.AttributeWeight[int, int, float64]("upper")
+
+	checkCirculation := func(t *testing.T, weightedGraph graph.WeightedGraph[int, int, float64], circulation *graphutil.Circulation[int, int, float64], demands map[int]float64) {
+		balance := make(map[int]float64)
+		for key, value := range circulation.EdgeFlows() {
+			e, ok := circulation.Edge(key)
+			if !ok {
+				t.Fatalf("edge %v must be in circulation", key)
+			}
+			if value < lower(e) || value > upper(e) {
+				t.Fatalf("flow %v of edge %v is out of bounds", value, key)
+			}
+			balance[e.To().Key()] += value
+			balance[e.From().Key()] -= value
+		}
+		for _, node := range weightedGraph.Nodes() {
+			if balance[node.Key()] != demands[node.Key()] {
+				t.Fatalf("demand of node %v isn't satisfied", node.Key())
+			}
+		}
+	}
+
+	t.Run("cycle", func(t *testing.T) {
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 3).WithAttributes(bounds(1, 3))},
+			2: {graph.NewLength(3, 2).WithAttributes(bounds(0, 2))},
+			3: {graph.NewLength(1, 4).WithAttributes(bounds(2, 4))},
+		}
+		weightedGraph := newWeightedGraph(t, dependencies)
+		circulation, err := graphutil.FeasibleCirculation(weightedGraph, lower, upper, nil)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		checkCirculation(t, weightedGraph, circulation, nil)
+		for key, value := range circulation.EdgeFlows() {
+			if value != 2 {
+				t.Fatalf("incorrect flow %v of edge %v", value, key)
+			}
+		}
+	})
+
+	//   [0, 2]
+	// 1 -> -> 2
+	//  \      | [0, 5]
+	//   \-> ->3
+	//   [1, 1]
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 2).WithAttributes(bounds(0, 2)), graph.NewLength(3, 1).WithAttributes(bounds(1, 1))},
+		2: {graph.NewLength(3, 5).WithAttributes(bounds(0, 5))},
+		3: {},
+	}
+	weightedGraph := newWeightedGraph(t, dependencies)
+
+	t.Run("demands", func(t *testing.T) {
+		demands := map[int]float64{1: -3, 3: 3}
+		circulation, err := graphutil.FeasibleCirculation(weightedGraph, lower, upper, demands)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		checkCirculation(t, weightedGraph, circulation, demands)
+	})
+
+	t.Run("infeasible", func(t *testing.T) {
+		_, err := graphutil.FeasibleCirculation(weightedGraph, lower, upper, map[int]float64{1: -4, 3: 4})
+		var circulationErr *graphutil.InfeasibleCirculationError[int, int, float64]
+		if !errors.Is(err, graphutil.ErrInfeasibleFlow) || !errors.As(err, &circulationErr) {
+			t.Fatal("err must be infeasible circulation error")
+		}
+		if len(circulationErr.Nodes) != 2 || circulationErr.Demand != 4 || circulationErr.Capacity != 3 || len(circulationErr.Edges) != 2 {
+			t.Fatalf("incorrect certificate %v", circulationErr)
+		}
+
+		for _, demands := range []map[int]float64{{1: -3, 3: 4}, {1: -4, 3: 3}} {
+			_, err = graphutil.FeasibleCirculation(weightedGraph, lower, upper, demands)
+			var imbalanceErr *graphutil.ImbalanceError[float64]
+			if !errors.Is(err, graphutil.ErrInfeasibleFlow) || !errors.As(err, &imbalanceErr) || imbalanceErr.Total != demands[1]+demands[3] {
+				t.Fatal("err must be imbalance error")
+			}
+		}
+
+		_, err = graphutil.FeasibleCirculation(weightedGraph, upper, lower, nil)
+		var boundsErr *graphutil.BoundsError
+		if !errors.As(err, &boundsErr) || boundsErr.Lower.(float64) <= boundsErr.Upper.(float64) {
+			t.Fatal("err must be bounds error if lower bound exceeds upper one")
+		}
+
+		_, err = graphutil.FeasibleCirculation(weightedGraph, lower, upper, map[int]float64{4: 1})
+		if !errors.Is(err, graph.ErrUnknownNode) {
+			t.Fatal("err must be unknown node error")
+		}
+	})
+
+	t.Run("undirected graph", func(t *testing.T) {
+		count := 0
+		edgeKeyGen := func() int {
+			count++
+			return count
+		}
+		undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 5).WithAttributes(bounds(2, 5))},
+		}, edgeKeyGen))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		_, err = graphutil.FeasibleCirculation[int, int, float64](undirectedGraph, lower, upper, nil)
+		var lowerBoundErr *graphutil.LowerBoundError
+		if !errors.Is(err, graphutil.ErrLowerBound) || !errors.As(err, &lowerBoundErr) || lowerBoundErr.Lower != 2.0 {
+			t.Fatal("err must be lower bound error for undirected edge")
+		}
+		zero := func(graph.Edge[int, int, float64]) float64 { return 0 }
+		circulation, err := graphutil.FeasibleCirculation[int, int, float64](undirectedGraph, zero, upper, map[int]float64{1: 3, 2: -3})
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if e, ok := circulation.Edge(1); !ok || e.From().Key() != 2 || circulation.EdgeFlow(1) != 3 {
+			t.Fatal("flow must pass undirected edge from 2 to 1")
+		}
+	})
+}
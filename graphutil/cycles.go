@@ -0,0 +1,84 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// FindCycles returns all elementary cycles of graph via Johnson's algorithm.
+func FindCycles[T comparable](directedGraph graph.DirectedGraph[T]) [][]graph.Node[T] {
+	nodes, order := directedGraph.Nodes(), make(map[T]int)
+	for i, n := range nodes {
+		order[n.Key()] = i
+	}
+
+	res := make([][]graph.Node[T], 0)
+	for i, start := range nodes {
+		component := make(map[T]struct{})
+		for _, c := range tarjan(nodes[i:], func(key T) bool { return order[key] >= i }) {
+			if containsNode(c, start.Key()) {
+				for _, n := range c {
+					component[n.Key()] = struct{}{}
+				}
+				break
+			}
+		}
+
+		blocked, blockedBy := make(map[T]bool), make(map[T]map[T]struct{})
+		path := make([]graph.Node[T], 0)
+
+		var unblock func(key T)
+		unblock = func(key T) {
+			blocked[key] = false
+			for k := range blockedBy[key] {
+				delete(blockedBy[key], k)
+				if blocked[k] {
+					unblock(k)
+				}
+			}
+		}
+
+		var circuit func(n graph.Node[T]) bool
+		circuit = func(n graph.Node[T]) bool {
+			found := false
+			path, blocked[n.Key()] = append(path, n), true
+			for _, child := range n.Children() {
+				if _, ok := component[child.Key()]; !ok {
+					continue
+				}
+				if child.Key() == start.Key() {
+					cycle := make([]graph.Node[T], len(path))
+					copy(cycle, path)
+					res, found = append(res, cycle), true
+				} else if !blocked[child.Key()] && circuit(child) {
+					found = true
+				}
+			}
+			if found {
+				unblock(n.Key())
+			} else {
+				for _, child := range n.Children() {
+					if _, ok := component[child.Key()]; !ok {
+						continue
+					}
+					if blockedBy[child.Key()] == nil {
+						blockedBy[child.Key()] = make(map[T]struct{})
+					}
+					blockedBy[child.Key()][n.Key()] = struct{}{}
+				}
+			}
+			path = path[:len(path)-1]
+			return found
+		}
+		circuit(start)
+	}
+	return res
+}
+
+func containsNode[T comparable](nodes []graph.Node[T], key T) bool {
+	for _, n := range nodes {
+		if n.Key() == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package graphutil_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestFindCycles(t *testing.T) {
+	t.Run("acyclic graph", func(t *testing.T) {
+		graph, _ := branchedGraph()
+		if cycles := graphutil.FindCycles(graph); len(cycles) != 0 {
+			t.Fatal("cycles must be empty")
+		}
+	})
+
+	t.Run("graph with several cycles", func(t *testing.T) {
+		// a <-> b -> c -> d -> b, c -> c
+		dependencies := map[string][]string{
+			"a": {"b"},
+			"b": {"a", "c"},
+			"c": {"c", "d"},
+			"d": {"b"},
+		}
+		graph, _ := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(dependencies))
+
+		actual := make([]string, 0)
+		for _, cycle := range graphutil.FindCycles(graph) {
+			actual = append(actual, normalizeCycle(cycle))
+		}
+		sort.Strings(actual)
+
+		expected := []string{"a b", "b c d", "c"}
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			t.Fatalf("incorrect cycles %v", actual)
+		}
+	})
+}
+
+// normalizeCycle rotates cycle to start from its smallest key
+func normalizeCycle(cycle []graph.Node[string]) string {
+	first := 0
+	for i, n := range cycle {
+		if n.Key() < cycle[first].Key() {
+			first = i
+		}
+	}
+	keys := make([]string, 0, len(cycle))
+	for i := range cycle {
+		keys = append(keys, cycle[(first+i)%len(cycle)].Key())
+	}
+	return strings.Join(keys, " ")
+}
@@ -1,51 +1,60 @@
 package graphutil
 
 import (
-	"errors"
-	"fmt"
-
 	"github.com/brmatvey/go-graphs/graph"
 )
 
-const max float64 = 1.7976931348623157e+308
-
-func Dijkstra[K, T comparable](start T, weightedGraph graph.WeightedGraph[K, T]) (map[T]float64, error) {
-	res, visited, nodes := make(map[T]float64), make(map[T]bool), weightedGraph.Nodes()
+func Dijkstra[K, T comparable, W graph.Weight](start T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (map[T]W, error) {
+	paths, err := DijkstraPaths(start, weightedGraph, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return paths.lengths(), nil
+}
 
-	for _, n := range nodes {
-		res[n.Key()] = max
+func DijkstraPaths[K, T comparable, W graph.Weight](start T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*ShortestPaths[K, T, W], error) {
+	if err := checkNodes[T](weightedGraph, start); err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+	targets := make([]T, 0, len(o.targets))
+	for target := range o.targets {
+		targets = append(targets, target)
+	}
+	if err := checkNodes[T](weightedGraph, targets...); err != nil {
+		return nil, err
 	}
-	res[start] = 0.0
+	return dijkstra[K, T, W](start, weightedGraph, o.outEdges(weightedGraph), o.weight, o.targets)
+}
 
-	for i := 0; i < len(nodes)+1; i++ {
-		currentKey, minLen := (*T)(nil), max
-		for _, n := range nodes {
-			if !visited[n.Key()] && res[n.Key()] < minLen {
-				key := n.Key()
-				currentKey, minLen = &key, res[n.Key()]
-			}
-		}
-		if currentKey == nil {
-			break
+func dijkstra[K, T comparable, W graph.Weight](start T, g graph.DirectedGraph[T], outEdges func(T) []graph.Edge[K, T, W], weight WeightFunc[K, T, W], targets map[T]struct{}) (*ShortestPaths[K, T, W], error) {
+	res, visited := newShortestPaths[K, T, W](start, g), make(map[T]bool)
+	remaining := len(targets)
+
+	q := newPriorityQueue[T, W]()
+	q.Push(start, 0)
+	for !q.Empty() {
+		currentKey, d := q.Pop()
+		if visited[currentKey] || d > res.distances[currentKey] {
+			continue
 		}
-		visited[*currentKey] = true
-		currentNode, ok := weightedGraph.Node(*currentKey)
-		if !ok {
-			return nil, errors.New(fmt.Sprintf("node %v is not found", *currentKey))
+		visited[currentKey] = true
+		if _, ok := targets[currentKey]; ok {
+			if remaining--; remaining == 0 {
+				res.keepSettled(visited)
+				break
+			}
 		}
-		for _, n := range currentNode.Children() {
-			if visited[n.Key()] {
+		for _, e := range outEdges(currentKey) {
+			if visited[e.To().Key()] {
 				continue
 			}
-			e, ok := weightedGraph.FindEdge(currentNode.Key(), n.Key())
-			if !ok {
-				return nil, errors.New(fmt.Sprintf("edge from %v to %v is not found", currentNode.Key(), n.Key()))
-			}
-			if e.Weight() < 0 {
-				return nil, errors.New("negative weight in edge in graph")
+			w := weight(e)
+			if w < 0 {
+				return nil, &NegativeWeightError{Key: e.Key(), From: e.From().Key(), To: e.To().Key(), Weight: w}
 			}
-			if res[n.Key()] > res[currentNode.Key()]+e.Weight() {
-				res[n.Key()] = res[currentNode.Key()] + e.Weight()
+			if res.relax(e, w) {
+				q.Push(e.To().Key(), res.distances[e.To().Key()])
 			}
 		}
 	}
@@ -1,6 +1,7 @@
 package graphutil_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/brmatvey/go-graphs/graph"
@@ -11,7 +12,7 @@ func TestDijkstra(t *testing.T) {
 	t.Run("test linear graph", func(t *testing.T) {
 		//   1    2    3
 		// 1 -> 2 -> 3 -> 4
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 1)},
 			2: {graph.NewLength(3, 2)},
 			3: {graph.NewLength(4, 3)},
@@ -45,7 +46,7 @@ func TestDijkstra(t *testing.T) {
 		//   \->3 -> 5 ->/    /
 		//     2 \           / 3
 		//        \-> 6 -> ->
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 6), graph.NewLength(3, 1)},
 			2: {graph.NewLength(4, 7)},
 			3: {graph.NewLength(5, 3), graph.NewLength(6, 2)},
@@ -77,4 +78,198 @@ func TestDijkstra(t *testing.T) {
 		}
 
 	})
+	t.Run("test early exit on targets", func(t *testing.T) {
+		//   6   7    8       10
+		// 1 ->2 -> 4 -> -> 7 -> -> -> 8
+		// 1\      3    5 /    /
+		//   \->3 -> 5 ->/    /
+		//     2 \           / 3
+		//        \-> 6 -> ->
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 6), graph.NewLength(3, 1)},
+			2: {graph.NewLength(4, 7)},
+			3: {graph.NewLength(5, 3), graph.NewLength(6, 2)},
+			4: {graph.NewLength(7, 8)},
+			5: {graph.NewLength(7, 5)},
+			6: {graph.NewLength(8, 3)},
+			7: {graph.NewLength(8, 10)},
+			8: {},
+		}
+
+		paths, err := graphutil.DijkstraPaths(1, newWeightedGraph(t, dependencies), graphutil.WithTargets[int, int, float64](3))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		if d, ok := paths.Distance(3); !ok || d != 1 {
+			t.Fatal("incorrect length")
+		}
+		if paths.Reachable(8) {
+			t.Fatal("search must stop before node 8")
+		}
+	})
+
+	t.Run("test early exit keeps only settled nodes", func(t *testing.T) {
+		//    10
+		// 1 -> -> 2
+		// 1\     / 1
+		//   \-> 3
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 10), graph.NewLength(3, 1)},
+			2: {},
+			3: {graph.NewLength(2, 1)},
+		}
+
+		paths, err := graphutil.DijkstraPaths(1, newWeightedGraph(t, dependencies), graphutil.WithTargets[int, int, float64](3))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if d, ok := paths.Distance(3); !ok || d != 1 {
+			t.Fatal("incorrect length")
+		}
+		if _, ok := paths.Distance(2); ok || paths.Reachable(2) {
+			t.Fatal("distance of node 2 isn't final")
+		}
+		if _, _, err = paths.PathTo(2); err == nil {
+			t.Fatal("path to node 2 isn't final")
+		}
+		if len(paths.Unreachable()) != 0 {
+			t.Fatal("unsettled nodes aren't unreachable")
+		}
+	})
+
+	t.Run("test graph with negative edge", func(t *testing.T) {
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 1)},
+			2: {graph.NewLength(3, -1)},
+			3: {},
+		}
+
+		_, err := graphutil.Dijkstra(1, newWeightedGraph(t, dependencies))
+		if err == nil {
+			t.Fatal("error must not be nil")
+		}
+	})
+	t.Run("test undirected graph", func(t *testing.T) {
+		//   1    1
+		// 1 -- 2 -- 3
+		//  \    5   /
+		//   \------/
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 1), graph.NewLength(3, 5)},
+			2: {graph.NewLength(3, 1)},
+		}
+		count := 0
+		edgeKeyGen := func() int {
+			count++
+			return count
+		}
+
+		undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(dependencies, edgeKeyGen))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		paths, err := graphutil.DijkstraPaths[int, int, float64](3, undirectedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		edges, length, err := paths.PathTo(1)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if length != 2 {
+			t.Fatal("incorrect length")
+		}
+		checkPath(t, edges, 3, 2, 1)
+	})
+	t.Run("test multigraph", func(t *testing.T) {
+		//   5, 2    1
+		// 1 => 2 -> 3
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 5), graph.NewLength(2, 2)},
+			2: {graph.NewLength(3, 1)},
+		}
+		count := 0
+		edgeKeyGen := func() int {
+			count++
+			return count
+		}
+
+		weightedGraph, err := graph.NewWeightedMultigraphFromCreator(graph.NewWeightedGraphCreator(dependencies, edgeKeyGen))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		paths, err := graphutil.DijkstraPaths(1, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		edges, length, err := paths.PathTo(3)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if length != 3 || edges[0].Weight() != 2 {
+			t.Fatal("lighter parallel edge must be used")
+		}
+	})
+	t.Run("test integer weights", func(t *testing.T) {
+		//   cents of dollar
+		// 1 -> -> -> -> -> 3
+		//  \ 1         1 /
+		//   \-> -> 2 -> /
+		dependencies := map[int][]graph.Length[int, int64]{
+			1: {graph.NewLengthOf[int, int64](2, 1), graph.NewLengthOf[int, int64](3, 100)},
+			2: {graph.NewLengthOf[int, int64](3, 1)},
+			3: {},
+			4: {},
+		}
+
+		lengths, err := graphutil.Dijkstra(1, newWeightedGraph(t, dependencies))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		if lengths[3] != 2 || lengths[4] != graph.Infinity[int64]() {
+			t.Fatal("incorrect length")
+		}
+	})
+}
+
+// BenchmarkDijkstraTargets searches neighbor of start node in large grid, so it must not depend on size of graph.
+func BenchmarkDijkstraTargets(b *testing.B) {
+	for _, size := range []int{100, 400} {
+		dependencies := make(map[int][]graph.Length[int, float64], size*size)
+		for x := 0; x < size; x++ {
+			for y := 0; y < size; y++ {
+				lengths := make([]graph.Length[int, float64], 0)
+				if x+1 < size {
+					lengths = append(lengths, graph.NewLength((x+1)*size+y, 1))
+				}
+				if y+1 < size {
+					lengths = append(lengths, graph.NewLength(x*size+y+1, 1))
+				}
+				dependencies[x*size+y] = lengths
+			}
+		}
+		count := 0
+		weightedGraph, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, func() int { count++; return count }))
+		if err != nil {
+			b.Fatal("error must be nil")
+		}
+
+		b.Run(fmt.Sprintf("size %d", size*size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				paths, err := graphutil.DijkstraPaths(0, weightedGraph, graphutil.WithTargets[int, int, float64](1))
+				if err != nil {
+					b.Fatal("error must be nil")
+				}
+				if _, _, err = paths.PathTo(1); err != nil {
+					b.Fatal("error must be nil")
+				}
+			}
+		})
+	}
 }
@@ -0,0 +1,72 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// Dinic saturates blocking flows in level graphs built by bfs, it works in O(V^2 * E).
+func Dinic[K, T comparable, W graph.Weight](start, stop T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*Flow[K, T, W], error) {
+	if err := checkNodes[T](weightedGraph, start, stop); err != nil {
+		return nil, err
+	}
+	n, err := newNetwork(weightedGraph, newOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return newFlow(start, stop, dinic(n, n.index[start], n.index[stop]), n), nil
+}
+
+func dinic[K, T comparable, W graph.Weight](n *network[K, T, W], s, t int) W {
+	value := W(0)
+	if s == t {
+		return value
+	}
+	levels, iterators := make([]int, len(n.adjacency)), make([]int, len(n.adjacency))
+
+	var augment func(current int, limit W) W
+	augment = func(current int, limit W) W {
+		if current == t {
+			return limit
+		}
+		for ; iterators[current] < len(n.adjacency[current]); iterators[current]++ {
+			arc := n.adjacency[current][iterators[current]]
+			next := n.to[arc]
+			if n.residual[arc] <= 0 || levels[next] != levels[current]+1 {
+				continue
+			}
+			bottleneck := limit
+			if n.residual[arc] < bottleneck {
+				bottleneck = n.residual[arc]
+			}
+			if delta := augment(next, bottleneck); delta > 0 {
+				n.push(arc, delta)
+				return delta
+			}
+		}
+		return 0
+	}
+
+	for {
+		for i := range levels {
+			levels[i], iterators[i] = -1, 0
+		}
+		levels[s] = 0
+		for queue := []int{s}; len(queue) > 0; queue = queue[1:] {
+			for _, arc := range n.adjacency[queue[0]] {
+				if next := n.to[arc]; n.residual[arc] > 0 && levels[next] < 0 {
+					levels[next] = levels[queue[0]] + 1
+					queue = append(queue, next)
+				}
+			}
+		}
+		if levels[t] < 0 {
+			return value
+		}
+		for delta := augment(s, graph.Infinity[W]()); delta > 0; delta = augment(s, graph.Infinity[W]()) {
+			if value = add(value, delta); value == graph.Infinity[W]() {
+				// path of unlimited capacity
+				return value
+			}
+		}
+	}
+}
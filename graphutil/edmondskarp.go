@@ -0,0 +1,51 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// EdmondsKarp augments flow along the shortest paths found by bfs, so it works in O(V * E^2) for any capacities.
+func EdmondsKarp[K, T comparable, W graph.Weight](start, stop T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*Flow[K, T, W], error) {
+	if err := checkNodes[T](weightedGraph, start, stop); err != nil {
+		return nil, err
+	}
+	n, err := newNetwork(weightedGraph, newOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+
+	s, t, value := n.index[start], n.index[stop], W(0)
+	for s != t {
+		parents := make([]int, len(n.keys))
+		for i := range parents {
+			parents[i] = -1
+		}
+		for queue := []int{s}; len(queue) > 0 && parents[t] < 0; queue = queue[1:] {
+			for _, arc := range n.adjacency[queue[0]] {
+				if next := n.to[arc]; n.residual[arc] > 0 && next != s && parents[next] < 0 {
+					parents[next] = arc
+					queue = append(queue, next)
+				}
+			}
+		}
+		if parents[t] < 0 {
+			break
+		}
+
+		delta := n.residual[parents[t]]
+		for current := t; current != s; current = n.to[parents[current]^1] {
+			if residual := n.residual[parents[current]]; residual < delta {
+				delta = residual
+			}
+		}
+		for current := t; current != s; current = n.to[parents[current]^1] {
+			n.push(parents[current], delta)
+		}
+		if value = add(value, delta); value == graph.Infinity[W]() {
+			// path of unlimited capacity
+			break
+		}
+	}
+
+	return newFlow(start, stop, value, n), nil
+}
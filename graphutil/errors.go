@@ -0,0 +1,131 @@
+package graphutil
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// Sentinel errors, every error of package wraps one of them, so they could be checked via errors.Is.
+var (
+	ErrNegativeWeight = errors.New("negative weight")
+	ErrNoPath         = errors.New("no path")
+	ErrCycle          = errors.New("cycle")
+	ErrNegativeCycle  = errors.New("negative cycle")
+	ErrInfeasibleFlow = errors.New("infeasible flow")
+	ErrLowerBound     = errors.New("unsupported lower bound")
+	ErrInadmissible   = errors.New("inadmissible heuristic")
+	ErrTooManyNodes   = errors.New("too many nodes")
+)
+
+// NegativeWeightError is returned by algorithms which don't support negative weights.
+type NegativeWeightError struct {
+	Key      any
+	From, To any
+	Weight   any
+}
+
+func (e *NegativeWeightError) Error() string {
+	return fmt.Sprintf("negative weight %v in edge %v from %v to %v", e.Weight, e.Key, e.From, e.To)
+}
+
+func (e *NegativeWeightError) Unwrap() error { return ErrNegativeWeight }
+
+// InadmissibleHeuristicError is returned when heuristic of Node overestimates real Distance to Goal.
+type InadmissibleHeuristicError struct {
+	Node, Goal any
+	Heuristic  any
+	Distance   any
+}
+
+func (e *InadmissibleHeuristicError) Error() string {
+	return fmt.Sprintf("heuristic overestimates distance from %v to %v: %v > %v", e.Node, e.Goal, e.Heuristic, e.Distance)
+}
+
+func (e *InadmissibleHeuristicError) Unwrap() error { return ErrInadmissible }
+
+// TooManyNodesError is returned when algorithm supports at most Limit nodes.
+type TooManyNodesError struct {
+	Count, Limit int
+}
+
+func (e *TooManyNodesError) Error() string {
+	return fmt.Sprintf("graph has %d nodes, at most %d are supported", e.Count, e.Limit)
+}
+
+func (e *TooManyNodesError) Unwrap() error { return ErrTooManyNodes }
+
+type NoPathError struct {
+	From, To any
+}
+
+func (e *NoPathError) Error() string {
+	return fmt.Sprintf("node %v is unreachable from %v", e.To, e.From)
+}
+
+func (e *NoPathError) Unwrap() error { return ErrNoPath }
+
+// InsufficientFlowError is returned when required amount of flow exceeds max flow.
+type InsufficientFlowError[W graph.Weight] struct {
+	Required, Max W
+}
+
+func (e *InsufficientFlowError[W]) Error() string {
+	return fmt.Sprintf("required flow %v exceeds max flow %v", e.Required, e.Max)
+}
+
+func (e *InsufficientFlowError[W]) Unwrap() error { return ErrInfeasibleFlow }
+
+// InfeasibleCirculationError is certificate of infeasibility: net demand of Nodes exceeds Capacity of the cut,
+// which is total upper bound of Edges entering Nodes minus total lower bound of Edges leaving them.
+type InfeasibleCirculationError[K, T comparable, W graph.Weight] struct {
+	Nodes    []T
+	Edges    []graph.Edge[K, T, W]
+	Demand   W
+	Capacity W
+}
+
+func (e *InfeasibleCirculationError[K, T, W]) Error() string {
+	return fmt.Sprintf("demand %v of nodes %v exceeds capacity %v of cut", e.Demand, e.Nodes, e.Capacity)
+}
+
+func (e *InfeasibleCirculationError[K, T, W]) Unwrap() error { return ErrInfeasibleFlow }
+
+// ImbalanceError is returned when sum of all demands isn't zero, so supply and consumption can't be equal.
+type ImbalanceError[W graph.Weight] struct {
+	Total W
+}
+
+func (e *ImbalanceError[W]) Error() string {
+	return fmt.Sprintf("sum of demands %v isn't zero", e.Total)
+}
+
+func (e *ImbalanceError[W]) Unwrap() error { return ErrInfeasibleFlow }
+
+// BoundsError is returned when Lower bound of edge exceeds its Upper bound.
+type BoundsError struct {
+	Key          any
+	From, To     any
+	Lower, Upper any
+}
+
+func (e *BoundsError) Error() string {
+	return fmt.Sprintf("lower bound %v exceeds upper bound %v in edge %v from %v to %v", e.Lower, e.Upper, e.Key, e.From, e.To)
+}
+
+func (e *BoundsError) Unwrap() error { return ErrInfeasibleFlow }
+
+// LowerBoundError is returned for undirected edge with nonzero lower bound: flow could pass such edge in either direction,
+// so the bound can't be reduced to bounds of its arcs.
+type LowerBoundError struct {
+	Key      any
+	From, To any
+	Lower    any
+}
+
+func (e *LowerBoundError) Error() string {
+	return fmt.Sprintf("lower bound %v of undirected edge %v from %v to %v", e.Lower, e.Key, e.From, e.To)
+}
+
+func (e *LowerBoundError) Unwrap() error { return ErrLowerBound }
@@ -0,0 +1,83 @@
+package graphutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestErrors(t *testing.T) {
+	t.Run("negative weight", func(t *testing.T) {
+		weightedGraph := newWeightedGraph(t, map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, -1)},
+			2: {},
+		})
+		_, err := graphutil.Dijkstra(1, weightedGraph)
+		var negativeWeightErr *graphutil.NegativeWeightError
+		if !errors.Is(err, graphutil.ErrNegativeWeight) || !errors.As(err, &negativeWeightErr) {
+			t.Fatal("err must be negative weight error")
+		}
+		if negativeWeightErr.From != 1 || negativeWeightErr.To != 2 || negativeWeightErr.Weight != -1.0 {
+			t.Fatal("incorrect negative weight edge")
+		}
+	})
+
+	t.Run("no path", func(t *testing.T) {
+		weightedGraph := newWeightedGraph(t, map[int][]graph.Length[int, float64]{1: {}, 2: {}})
+		paths, err := graphutil.DijkstraPaths(1, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		_, _, err = paths.PathTo(2)
+		var noPathErr *graphutil.NoPathError
+		if !errors.Is(err, graphutil.ErrNoPath) || !errors.As(err, &noPathErr) || noPathErr.From != 1 || noPathErr.To != 2 {
+			t.Fatal("err must be no path error")
+		}
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		weightedGraph := newWeightedGraph(t, map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, -1)},
+			2: {graph.NewLength(1, -1)},
+		})
+		if _, err := graphutil.BellmanFord(1, weightedGraph); !errors.Is(err, graphutil.ErrNegativeCycle) {
+			t.Fatal("err must be negative cycle error")
+		}
+		if _, err := graphutil.TopologicalSort[int](weightedGraph); !errors.Is(err, graphutil.ErrCycle) {
+			t.Fatal("err must be cycle error")
+		}
+	})
+
+	t.Run("inadmissible heuristic", func(t *testing.T) {
+		weightedGraph := newWeightedGraph(t, map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 1)},
+			2: {},
+		})
+		err := graphutil.CheckAdmissible(2, weightedGraph, func(key int) float64 { return 5 })
+		var inadmissibleErr *graphutil.InadmissibleHeuristicError
+		if !errors.Is(err, graphutil.ErrInadmissible) || !errors.As(err, &inadmissibleErr) {
+			t.Fatal("err must be inadmissible heuristic error")
+		}
+		if inadmissibleErr.Goal != 2 || inadmissibleErr.Heuristic != 5.0 || inadmissibleErr.Distance != 0.0 {
+			t.Fatal("incorrect inadmissible heuristic error")
+		}
+	})
+
+	t.Run("too many nodes", func(t *testing.T) {
+		structure := make(map[int][]int)
+		for i := 0; i < 21; i++ {
+			structure[i] = []int{}
+		}
+		directedGraph, err := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(structure))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		_, err = graphutil.CountTopologicalSorts(directedGraph)
+		var tooManyNodesErr *graphutil.TooManyNodesError
+		if !errors.Is(err, graphutil.ErrTooManyNodes) || !errors.As(err, &tooManyNodesErr) || tooManyNodesErr.Count != 21 || tooManyNodesErr.Limit != 20 {
+			t.Fatal("err must be too many nodes error")
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// ResidualKey identifies edge of residual graph: edge of original graph and direction it is passed in.
+type ResidualKey[K, T comparable] struct {
+	Edge     K
+	From, To T
+}
+
+// FlowPath is path from start to stop node carrying part of flow.
+type FlowPath[K, T comparable, W graph.Weight] struct {
+	Edges []graph.Edge[K, T, W]
+	Value W
+}
+
+// Flow is result of max-flow algorithms.
+type Flow[K, T comparable, W graph.Weight] struct {
+	start, stop T
+	value       W
+	flows       map[K]W
+	edges       map[K]graph.Edge[K, T, W]
+	paths       []FlowPath[K, T, W]
+	network     *network[K, T, W]
+	residual    graph.WeightedGraph[ResidualKey[K, T], T, W]
+}
+
+func newFlow[K, T comparable, W graph.Weight](start, stop T, value W, n *network[K, T, W]) *Flow[K, T, W] {
+	f := &Flow[K, T, W]{start: start, stop: stop, value: value, network: n}
+	f.flows, f.edges = edgeFlows(n.edges, n.flow)
+	f.paths = f.decompose()
+	return f
+}
+
+// edgeFlows merges flows of arcs by edge keys, edges are oriented along their flows.
+func edgeFlows[K, T comparable, W graph.Weight](arcs []graph.Edge[K, T, W], flow func(int) W) (map[K]W, map[K]graph.Edge[K, T, W]) {
+	flows, edges := make(map[K]W), make(map[K]graph.Edge[K, T, W])
+	for pair, e := range arcs {
+		current, ok := edges[e.Key()]
+		switch {
+		case !ok:
+			flows[e.Key()], edges[e.Key()] = flow(pair), e
+		case current.From().Key() == e.From().Key():
+			flows[e.Key()] = add(flows[e.Key()], flow(pair))
+		case flows[e.Key()] >= flow(pair):
+			// opposite arcs of undirected edge cancel each other
+			flows[e.Key()] -= flow(pair)
+		default:
+			flows[e.Key()], edges[e.Key()] = flow(pair)-flows[e.Key()], e
+		}
+	}
+	return flows, edges
+}
+
+func (f *Flow[K, T, W]) Start() T { return f.start }
+func (f *Flow[K, T, W]) Stop() T  { return f.stop }
+func (f *Flow[K, T, W]) Value() W { return f.value }
+
+// EdgeFlow returns flow through edge, undirected edge passes it in direction of Edge(key).
+func (f *Flow[K, T, W]) EdgeFlow(key K) W { return f.flows[key] }
+
+func (f *Flow[K, T, W]) EdgeFlows() map[K]W {
+	flows := make(map[K]W, len(f.flows))
+	for key, value := range f.flows {
+		flows[key] = value
+	}
+	return flows
+}
+
+// Edge returns edge oriented along its flow.
+func (f *Flow[K, T, W]) Edge(key K) (graph.Edge[K, T, W], bool) {
+	e, ok := f.edges[key]
+	return e, ok
+}
+
+// Residual returns graph of remaining capacities, edge passed backwards has capacity equal to its flow.
+// The graph is built on the first call.
+func (f *Flow[K, T, W]) Residual() graph.WeightedGraph[ResidualKey[K, T], T, W] {
+	if f.residual == nil {
+		f.residual = f.network.residualGraph()
+	}
+	return f.residual
+}
+
+// Paths returns decomposition of flow into paths from start to stop, circulations are not included.
+func (f *Flow[K, T, W]) Paths() []FlowPath[K, T, W] {
+	return append([]FlowPath[K, T, W](nil), f.paths...)
+}
+
+func (f *Flow[K, T, W]) decompose() []FlowPath[K, T, W] {
+	remaining, out := make(map[K]W), make(map[T][]graph.Edge[K, T, W])
+	for key, value := range f.flows {
+		if e := f.edges[key]; value > 0 && e.From().Key() != e.To().Key() {
+			remaining[key], out[e.From().Key()] = value, append(out[e.From().Key()], e)
+		}
+	}
+	next := func(key T) (graph.Edge[K, T, W], bool) {
+		for edges := out[key]; len(edges) > 0; edges = edges[1:] {
+			if remaining[edges[0].Key()] > 0 {
+				out[key] = edges
+				return edges[0], true
+			}
+		}
+		delete(out, key)
+		return nil, false
+	}
+	bottleneck := func(edges []graph.Edge[K, T, W]) W {
+		res := remaining[edges[0].Key()]
+		for _, e := range edges {
+			if remaining[e.Key()] < res {
+				res = remaining[e.Key()]
+			}
+		}
+		for _, e := range edges {
+			remaining[e.Key()] -= res
+		}
+		return res
+	}
+
+	res := make([]FlowPath[K, T, W], 0)
+	for f.start != f.stop {
+		edges, positions := make([]graph.Edge[K, T, W], 0), map[T]int{f.start: 0}
+		for current := f.start; current != f.stop; {
+			e, ok := next(current)
+			if !ok {
+				return res
+			}
+			edges, current = append(edges, e), e.To().Key()
+			if i, ok := positions[current]; ok {
+				// cancel circulation and continue from the beginning of it
+				bottleneck(edges[i:])
+				for _, cycleEdge := range edges[i+1:] {
+					delete(positions, cycleEdge.From().Key())
+				}
+				edges = edges[:i]
+				continue
+			}
+			positions[current] = len(edges)
+		}
+		res = append(res, FlowPath[K, T, W]{Edges: edges, Value: bottleneck(edges)})
+	}
+	return res
+}
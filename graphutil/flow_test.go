@@ -0,0 +1,216 @@
+package graphutil_test
+
+import (
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+type maxFlowAlgorithm func(int, int, graph.WeightedGraph[int, int, float64], ...graphutil.Option[int, int, float64]) (*graphutil.Flow[int, int, float64], error)
+
+var maxFlowAlgorithms = map[string]maxFlowAlgorithm{
+	"edmonds-karp": graphutil.EdmondsKarp[int, int, float64],
+	"dinic":        graphutil.Dinic[int, int, float64],
+	"push-relabel": graphutil.PushRelabel[int, int, float64],
+}
+
+func TestMaxFlow(t *testing.T) {
+	testCases := []struct {
+		name         string
+		dependencies map[int][]graph.Length[int, float64]
+		start, stop  int
+		expected     float64
+	}{
+		{
+			name: "random graph",
+			dependencies: map[int][]graph.Length[int, float64]{
+				1: {graph.NewLength(2, 15), graph.NewLength(3, 1)},
+				2: {graph.NewLength(4, 16)},
+				3: {graph.NewLength(5, 3), graph.NewLength(6, 2)},
+				4: {graph.NewLength(7, 8), graph.NewLength(6, 10)},
+				5: {graph.NewLength(7, 5)},
+				6: {graph.NewLength(8, 3)},
+				7: {graph.NewLength(8, 10)},
+				8: {},
+			},
+			start: 1, stop: 8, expected: 12,
+		},
+		{
+			name: "graph with back edges (see png)",
+			dependencies: map[int][]graph.Length[int, float64]{
+				1:  {graph.NewLength(2, 6), graph.NewLength(3, 6), graph.NewLength(4, 8), graph.NewLength(5, 9)},
+				2:  {graph.NewLength(3, 3), graph.NewLength(6, 4)},
+				3:  {graph.NewLength(4, 4), graph.NewLength(7, 4)},
+				4:  {graph.NewLength(5, 3), graph.NewLength(8, 5), graph.NewLength(9, 10)},
+				5:  {graph.NewLength(9, 6)},
+				6:  {graph.NewLength(3, 9), graph.NewLength(10, 5)},
+				7:  {graph.NewLength(4, 10), graph.NewLength(6, 8), graph.NewLength(11, 5)},
+				8:  {graph.NewLength(7, 8), graph.NewLength(12, 5), graph.NewLength(13, 12)},
+				9:  {graph.NewLength(8, 7), graph.NewLength(13, 7)},
+				10: {graph.NewLength(7, 10), graph.NewLength(14, 6)},
+				11: {graph.NewLength(8, 12), graph.NewLength(10, 8), graph.NewLength(14, 9)},
+				12: {graph.NewLength(11, 8), graph.NewLength(14, 7)},
+				13: {graph.NewLength(12, 7), graph.NewLength(14, 6)},
+				14: {},
+			},
+			start: 1, stop: 14, expected: 26,
+		},
+		{
+			name: "circles",
+			dependencies: map[int][]graph.Length[int, float64]{
+				1: {graph.NewLength(2, 7)},
+				2: {graph.NewLength(1, 7), graph.NewLength(3, 8)},
+				3: {},
+			},
+			start: 1, stop: 3, expected: 7,
+		},
+		{
+			name: "unreachable stop",
+			dependencies: map[int][]graph.Length[int, float64]{
+				1: {graph.NewLength(2, 7)},
+				2: {},
+				3: {graph.NewLength(2, 8)},
+			},
+			start: 1, stop: 3, expected: 0,
+		},
+		{
+			name: "same start and stop",
+			dependencies: map[int][]graph.Length[int, float64]{
+				1: {graph.NewLength(2, 7)},
+				2: {graph.NewLength(1, 7)},
+			},
+			start: 1, stop: 1, expected: 0,
+		},
+	}
+
+	t.Run("ford-fulkerson", func(t *testing.T) {
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				value, err := graphutil.FordFulkerson(tc.start, tc.stop, newWeightedGraph(t, tc.dependencies))
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				if value != tc.expected {
+					t.Fatalf("incorrect flow value %v", value)
+				}
+			})
+		}
+	})
+
+	for name, algorithm := range maxFlowAlgorithms {
+		t.Run(name, func(t *testing.T) {
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					weightedGraph := newWeightedGraph(t, tc.dependencies)
+					flow, err := algorithm(tc.start, tc.stop, weightedGraph)
+					if err != nil {
+						t.Fatal("error must be nil")
+					}
+					checkFlow(t, weightedGraph, flow, tc.expected)
+				})
+			}
+
+			t.Run("multigraph", func(t *testing.T) {
+				//   5, 2    10
+				// 1 => 2 -> 3
+				count := 0
+				weightedGraph, err := graph.NewWeightedMultigraphFromCreator(graph.NewWeightedGraphCreator(map[int][]graph.Length[int, float64]{
+					1: {graph.NewLength(2, 5), graph.NewLength(2, 2)},
+					2: {graph.NewLength(3, 10)},
+				}, func() int { count++; return count }))
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				flow, err := algorithm(1, 3, weightedGraph)
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				checkFlow(t, weightedGraph, flow, 7)
+			})
+
+			t.Run("undirected graph", func(t *testing.T) {
+				//    3     2
+				// 1 --- 2 --- 4
+				//  \ 2  | 4  / 3
+				//   \-- 3 --/
+				count := 0
+				undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(map[int][]graph.Length[int, float64]{
+					1: {graph.NewLength(2, 3), graph.NewLength(3, 2)},
+					2: {graph.NewLength(3, 4), graph.NewLength(4, 2)},
+					3: {graph.NewLength(4, 3)},
+				}, func() int { count++; return count }))
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				flow, err := algorithm(1, 4, undirectedGraph)
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				checkFlow(t, undirectedGraph, flow, 5)
+			})
+
+			t.Run("negative capacity", func(t *testing.T) {
+				weightedGraph := newWeightedGraph(t, map[int][]graph.Length[int, float64]{1: {graph.NewLength(2, -1)}, 2: {}})
+				if _, err := algorithm(1, 2, weightedGraph); err == nil {
+					t.Fatal("error must not be nil")
+				}
+			})
+		})
+	}
+}
+
+func checkFlow(t *testing.T, weightedGraph graph.WeightedGraph[int, int, float64], flow *graphutil.Flow[int, int, float64], expected float64) {
+	if flow.Value() != expected {
+		t.Fatalf("incorrect flow %v, expected %v", flow.Value(), expected)
+	}
+
+	balance := make(map[int]float64)
+	for _, e := range weightedGraph.Edges() {
+		value := flow.EdgeFlow(e.Key())
+		if value < 0 || value > e.Weight() {
+			t.Fatalf("incorrect flow %v through edge %v with capacity %v", value, e.Key(), e.Weight())
+		}
+		oriented, ok := flow.Edge(e.Key())
+		if !ok {
+			t.Fatalf("edge %v must be in flow", e.Key())
+		}
+		balance[oriented.From().Key()] -= value
+		balance[oriented.To().Key()] += value
+	}
+	for key, value := range balance {
+		switch {
+		case key == flow.Start() && key == flow.Stop():
+		case key == flow.Start() && value == -expected:
+		case key == flow.Stop() && value == expected:
+		case value == 0:
+		default:
+			t.Fatalf("flow is not conserved in node %v: %v", key, value)
+		}
+	}
+
+	total := 0.0
+	for _, p := range flow.Paths() {
+		if p.Value <= 0 || p.Edges[0].From().Key() != flow.Start() || p.Edges[len(p.Edges)-1].To().Key() != flow.Stop() {
+			t.Fatal("incorrect flow path")
+		}
+		for i := 1; i < len(p.Edges); i++ {
+			if p.Edges[i-1].To() != p.Edges[i].From() {
+				t.Fatal("flow path must be continuous")
+			}
+		}
+		total += p.Value
+	}
+	if total != expected {
+		t.Fatalf("paths carry %v instead of %v", total, expected)
+	}
+
+	for _, e := range flow.Residual().Edges() {
+		if e.Weight() <= 0 {
+			t.Fatal("residual capacity must be positive")
+		}
+		if e.From().Key() != e.Key().From || e.To().Key() != e.Key().To {
+			t.Fatal("incorrect residual edge key")
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func FloydWarshall[K, T comparable, W graph.Weight](weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*AllShortestPaths[K, T, W], error) {
+	o := newOptions(opts)
+	nodes, edges := weightedGraph.Nodes(), o.arcs(weightedGraph)
+	res := newAllShortestPaths[K, T, W](nodes)
+	for _, e := range edges {
+		from, to := e.From().Key(), e.To().Key()
+		if d, ok := res.distances[from][to]; !ok || d > o.weight(e) {
+			res.distances[from][to], res.next[from][to] = o.weight(e), e
+		}
+	}
+
+	for _, k := range nodes {
+		fromK := res.distances[k.Key()]
+		for _, i := range nodes {
+			toK, ok := res.distances[i.Key()][k.Key()]
+			if !ok {
+				continue
+			}
+			for j, viaK := range fromK {
+				if d, ok := res.distances[i.Key()][j]; !ok || d > add(toK, viaK) {
+					res.distances[i.Key()][j] = add(toK, viaK)
+					res.next[i.Key()][j] = res.next[i.Key()][k.Key()]
+				}
+			}
+		}
+	}
+
+	for _, n := range nodes {
+		if res.distances[n.Key()][n.Key()] < 0 {
+			_, err := potentials(nodes, edges, o.weight)
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,105 @@
+package graphutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestFloydWarshall(t *testing.T) {
+	t.Run("test graph with negative edges", func(t *testing.T) {
+		checkAllShortestPaths(t, graphutil.FloydWarshall[int, int, float64])
+	})
+
+	t.Run("test graph with negative circular dependencies", func(t *testing.T) {
+		checkNegativeCycle(t, graphutil.FloydWarshall[int, int, float64])
+	})
+}
+
+func checkAllShortestPaths(t *testing.T, algorithm func(graph.WeightedGraph[int, int, float64], ...graphutil.Option[int, int, float64]) (*graphutil.AllShortestPaths[int, int, float64], error)) {
+	//   4    -2
+	// 1 -> 2 -> 3
+	//  \   3   / 1
+	//   \-> 4 <
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 4), graph.NewLength(4, 3)},
+		2: {graph.NewLength(3, -2)},
+		3: {graph.NewLength(4, 1)},
+		4: {},
+	}
+
+	paths, err := algorithm(newWeightedGraph(t, dependencies))
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+
+	expected := map[int]map[int]float64{
+		1: {1: 0, 2: 4, 3: 2, 4: 3},
+		2: {2: 0, 3: -2, 4: -1},
+		3: {3: 0, 4: 1},
+		4: {4: 0},
+	}
+	distances := paths.Distances()
+	for from, row := range expected {
+		if len(distances[from]) != len(row) {
+			t.Fatalf("incorrect reachable nodes from %d", from)
+		}
+		for to, d := range row {
+			if actual, ok := paths.Distance(from, to); !ok || actual != d {
+				t.Fatalf("incorrect length from %d to %d", from, to)
+			}
+		}
+	}
+
+	edges, length, err := paths.Path(1, 4)
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	if length != 3 {
+		t.Fatal("incorrect length")
+	}
+	checkPath(t, edges, 1, 4)
+
+	edges, _, err = paths.Path(2, 4)
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	checkPath(t, edges, 2, 3, 4)
+
+	if _, _, err = paths.Path(4, 1); err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func checkNegativeCycle(t *testing.T, algorithm func(graph.WeightedGraph[int, int, float64], ...graphutil.Option[int, int, float64]) (*graphutil.AllShortestPaths[int, int, float64], error)) {
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 1)},
+		2: {graph.NewLength(3, -2)},
+		3: {graph.NewLength(2, 1)},
+	}
+
+	_, err := algorithm(newWeightedGraph(t, dependencies))
+	checkNegativeCycleError(t, err, -1, 2, 3)
+}
+
+func checkNegativeCycleError(t *testing.T, err error, weight float64, keys ...int) {
+	var cycleErr *graphutil.NegativeCycleError[int, int, float64]
+	if !errors.As(err, &cycleErr) {
+		t.Fatal("error must be NegativeCycleError")
+	}
+	if cycleErr.Weight != weight || len(cycleErr.Cycle) != len(keys) {
+		t.Fatalf("incorrect cycle %v", cycleErr)
+	}
+	// cycle may start from any of its nodes
+	shift := 0
+	for shift < len(keys) && keys[shift] != cycleErr.Cycle[0].From().Key() {
+		shift++
+	}
+	for i, e := range cycleErr.Cycle {
+		if e.From().Key() != keys[(i+shift)%len(keys)] || e.To().Key() != keys[(i+shift+1)%len(keys)] {
+			t.Fatalf("incorrect cycle %v", cycleErr)
+		}
+	}
+}
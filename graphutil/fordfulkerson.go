@@ -4,13 +4,20 @@ import (
 	"github.com/brmatvey/go-graphs/graph"
 )
 
-func FordFulkerson[K, T comparable](start, stop T, graph graph.WeightedGraph[K, T]) float64 {
-	res := 0.0
-	flows, paths := toFlowsAndPaths(graph)
+func FordFulkerson[K, T comparable, W graph.Weight](start, stop T, graph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (W, error) {
+	if err := checkNodes[T](graph, start, stop); err != nil {
+		return 0, err
+	}
+	if start == stop {
+		return 0, nil
+	}
+	o := newOptions(opts)
+	res := W(0)
+	flows, paths := toFlowsAndPaths(o.arcs(graph), o.weight)
 	for {
-		currentPath, err := findPathViaDfs[K, T](start, stop, paths)
+		currentPath, err := findPathViaDfs(start, stop, paths)
 		if err != nil {
-			return res
+			return res, nil
 		}
 
 		minWeight := flows[newPath(currentPath[0], currentPath[1])]
@@ -11,7 +11,7 @@ func TestFordFulkerson(t *testing.T) {
 	t.Run("test linear graph", func(t *testing.T) {
 		//   1    2    3
 		// 1 -> 2 -> 3 -> 4
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 1)},
 			2: {graph.NewLength(3, 2)},
 			3: {graph.NewLength(4, 3)},
@@ -28,14 +28,17 @@ func TestFordFulkerson(t *testing.T) {
 			t.Fatal("error must be nil")
 		}
 
-		flow := graphutil.FordFulkerson(1, 4, weightedGraph)
+		flow, err := graphutil.FordFulkerson(1, 4, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
 		if flow != 1 {
 			t.Fatal("incorrect flow")
 		}
 	})
 
 	t.Run("test random graph", func(t *testing.T) {
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1: {graph.NewLength(2, 15), graph.NewLength(3, 1)},
 			2: {graph.NewLength(4, 16)},
 			3: {graph.NewLength(5, 3), graph.NewLength(6, 2)},
@@ -56,7 +59,10 @@ func TestFordFulkerson(t *testing.T) {
 			t.Fatal("error must be nil")
 		}
 
-		flow := graphutil.FordFulkerson(1, 8, weightedGraph)
+		flow, err := graphutil.FordFulkerson(1, 8, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
 		if flow != 12 {
 			t.Fatal("incorrect flow")
 		}
@@ -64,7 +70,7 @@ func TestFordFulkerson(t *testing.T) {
 	})
 
 	t.Run("test random graph 2", func(t *testing.T) {
-		dependencies := map[rune][]graph.Length[rune]{
+		dependencies := map[rune][]graph.Length[rune, float64]{
 			'A': {graph.NewLength('B', 7), graph.NewLength('C', 4)},
 			'B': {graph.NewLength('C', 4), graph.NewLength('E', 2)},
 			'C': {graph.NewLength('D', 4), graph.NewLength('E', 8)},
@@ -82,14 +88,17 @@ func TestFordFulkerson(t *testing.T) {
 			t.Fatal("error must be nil")
 		}
 
-		flow := graphutil.FordFulkerson('A', 'F', weightedGraph)
+		flow, err := graphutil.FordFulkerson('A', 'F', weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
 		if flow != 10 {
 			t.Fatal("incorrect flow")
 		}
 	})
 
 	t.Run("test random graph 3 (see png)", func(t *testing.T) {
-		dependencies := map[int][]graph.Length[int]{
+		dependencies := map[int][]graph.Length[int, float64]{
 			1:  {graph.NewLength(2, 6), graph.NewLength(3, 6), graph.NewLength(4, 8), graph.NewLength(5, 9)},
 			2:  {graph.NewLength(3, 3), graph.NewLength(6, 4)},
 			3:  {graph.NewLength(4, 4), graph.NewLength(7, 4)},
@@ -116,14 +125,17 @@ func TestFordFulkerson(t *testing.T) {
 			t.Fatal("error must be nil")
 		}
 
-		flow := graphutil.FordFulkerson(1, 14, weightedGraph)
+		flow, err := graphutil.FordFulkerson(1, 14, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
 		if flow != 26 {
 			t.Fatal("incorrect flow")
 		}
 	})
 
 	t.Run("test with circles", func(t *testing.T) {
-		dependencies := map[rune][]graph.Length[rune]{
+		dependencies := map[rune][]graph.Length[rune, float64]{
 			'A': {graph.NewLength('B', 7)},
 			'B': {graph.NewLength('A', 7), graph.NewLength('C', 8)},
 			'C': {},
@@ -139,9 +151,38 @@ func TestFordFulkerson(t *testing.T) {
 			t.Fatal("error must be nil")
 		}
 
-		flow := graphutil.FordFulkerson('A', 'C', weightedGraph)
+		flow, err := graphutil.FordFulkerson('A', 'C', weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
 		if flow != 7 {
 			t.Fatal("incorrect flow")
 		}
 	})
+	t.Run("test multigraph", func(t *testing.T) {
+		//   5, 2    10
+		// 1 => 2 -> 3
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 5), graph.NewLength(2, 2)},
+			2: {graph.NewLength(3, 10)},
+		}
+		count := 0
+		edgeKeyGen := func() int {
+			count++
+			return count
+		}
+
+		weightedGraph, err := graph.NewWeightedMultigraphFromCreator(graph.NewWeightedGraphCreator(dependencies, edgeKeyGen))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+
+		flow, err := graphutil.FordFulkerson(1, 3, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if flow != 7 {
+			t.Fatal("parallel edges capacities must be summed")
+		}
+	})
 }
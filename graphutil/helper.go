@@ -1,8 +1,6 @@
 package graphutil
 
 import (
-	"errors"
-
 	"github.com/brmatvey/go-data-structs/slice"
 	"github.com/brmatvey/go-data-structs/stack"
 	"github.com/brmatvey/go-graphs/graph"
@@ -17,7 +15,7 @@ type path[T comparable] struct {
 	To   T
 }
 
-func findPathViaDfs[K, T comparable](from, to T, paths map[T]map[T]struct{}) ([]T, error) {
+func findPathViaDfs[T comparable](from, to T, paths map[T]map[T]struct{}) ([]T, error) {
 	s, res, colors := stack.New[T](), stack.New[T](), make(map[T]int)
 	s.Push(from)
 	for !s.Empty() {
@@ -43,7 +41,7 @@ func findPathViaDfs[K, T comparable](from, to T, paths map[T]map[T]struct{}) ([]
 			s.Pop()
 		}
 	}
-	return nil, errors.New("no path")
+	return nil, &NoPathError{From: from, To: to}
 }
 
 func stackToSlice[T any](s *stack.Stack[T]) []T {
@@ -56,10 +54,10 @@ func stackToSlice[T any](s *stack.Stack[T]) []T {
 	return res
 }
 
-func toFlowsAndPaths[K, T comparable](g graph.WeightedGraph[K, T]) (map[path[T]]float64, map[T]map[T]struct{}) {
-	flows, paths := make(map[path[T]]float64), make(map[T]map[T]struct{})
-	for _, e := range g.Edges() {
-		flows[newPath[T](e.From().Key(), e.To().Key())] = e.Weight()
+func toFlowsAndPaths[K, T comparable, W graph.Weight](edges []graph.Edge[K, T, W], capacity WeightFunc[K, T, W]) (map[path[T]]W, map[T]map[T]struct{}) {
+	flows, paths := make(map[path[T]]W), make(map[T]map[T]struct{})
+	for _, e := range edges {
+		flows[newPath[T](e.From().Key(), e.To().Key())] += capacity(e)
 		if paths[e.From().Key()] == nil {
 			paths[e.From().Key()] = make(map[T]struct{})
 		}
@@ -67,3 +65,35 @@ func toFlowsAndPaths[K, T comparable](g graph.WeightedGraph[K, T]) (map[path[T]]
 	}
 	return flows, paths
 }
+
+// checkNodes returns UnknownNodeError for the first key which is absent in graph
+func checkNodes[T comparable](g graph.DirectedGraph[T], keys ...T) error {
+	for _, key := range keys {
+		if _, ok := g.Node(key); !ok {
+			return &graph.UnknownNodeError{Key: key}
+		}
+	}
+	return nil
+}
+
+// arcs returns every edge in each direction it could be passed, so undirected edges are returned twice
+func arcs[K, T comparable, W graph.Weight](g graph.WeightedGraph[K, T, W]) []graph.Edge[K, T, W] {
+	res := make([]graph.Edge[K, T, W], 0)
+	for _, n := range g.Nodes() {
+		res = append(res, g.OutEdges(n.Key())...)
+	}
+	return res
+}
+
+// add saturates at infinity or at the least value instead of overflowing integer weights
+func add[W graph.Weight](lhs, rhs W) W {
+	sum := lhs + rhs
+	switch {
+	case rhs > 0 && sum < lhs:
+		return graph.Infinity[W]()
+	case rhs < 0 && sum > lhs:
+		// the largest integer wraps to the least one
+		return graph.Infinity[W]() + 1
+	}
+	return sum
+}
@@ -0,0 +1,55 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+func Johnson[K, T comparable, W graph.Weight](weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*AllShortestPaths[K, T, W], error) {
+	o := newOptions(opts)
+	nodes, edges := weightedGraph.Nodes(), o.arcs(weightedGraph)
+
+	nodePotentials, err := potentials(nodes, edges, o.weight)
+	if err != nil {
+		return nil, err
+	}
+
+	weight := func(e graph.Edge[K, T, W]) W {
+		w := o.weight(e) + nodePotentials[e.From().Key()] - nodePotentials[e.To().Key()]
+		if w < 0 {
+			// rounding error, reweighted edges are never negative
+			return 0
+		}
+		return w
+	}
+
+	res := newAllShortestPaths[K, T, W](nodes)
+	for _, n := range nodes {
+		start := n.Key()
+		tree, err := dijkstra[K, T, W](start, weightedGraph, o.outEdges(weightedGraph), weight, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var firstEdge func(to T) graph.Edge[K, T, W]
+		firstEdge = func(to T) graph.Edge[K, T, W] {
+			if e, ok := res.next[start][to]; ok {
+				return e
+			}
+			e := tree.edges[to]
+			if e.From().Key() != start {
+				e = firstEdge(e.From().Key())
+			}
+			res.next[start][to] = e
+			return e
+		}
+
+		for to, d := range tree.distances {
+			res.distances[start][to] = d - nodePotentials[start] + nodePotentials[to]
+			if to != start {
+				firstEdge(to)
+			}
+		}
+	}
+
+	return res, nil
+}
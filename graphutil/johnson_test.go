@@ -0,0 +1,17 @@
+package graphutil_test
+
+import (
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestJohnson(t *testing.T) {
+	t.Run("test graph with negative edges", func(t *testing.T) {
+		checkAllShortestPaths(t, graphutil.Johnson[int, int, float64])
+	})
+
+	t.Run("test graph with negative circular dependencies", func(t *testing.T) {
+		checkNegativeCycle(t, graphutil.Johnson[int, int, float64])
+	})
+}
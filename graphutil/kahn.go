@@ -0,0 +1,86 @@
+package graphutil
+
+import (
+	"sort"
+
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+func ByKey[T Ordered](lhs, rhs graph.Node[T]) bool { return lhs.Key() < rhs.Key() }
+
+func KahnSort[T comparable](directedGraph graph.DirectedGraph[T], less func(lhs, rhs graph.Node[T]) bool) ([]graph.Node[T], error) {
+	if less == nil {
+		less = func(lhs, rhs graph.Node[T]) bool { return false }
+	}
+	nodes, inDegrees := directedGraph.Nodes(), toInDegrees(directedGraph)
+	res, ready := make([]graph.Node[T], 0, len(nodes)), newBinaryHeap(less)
+	for _, n := range nodes {
+		if inDegrees[n.Key()] == 0 {
+			ready.Push(n)
+		}
+	}
+
+	for !ready.Empty() {
+		currentNode := ready.Pop()
+		res = append(res, currentNode)
+		for _, child := range currentNode.Children() {
+			if inDegrees[child.Key()]--; inDegrees[child.Key()] == 0 {
+				ready.Push(child)
+			}
+		}
+	}
+
+	if len(res) != len(nodes) {
+		_, err := TopologicalSort(directedGraph)
+		return nil, err
+	}
+	return res, nil
+}
+
+func TopologicalLayers[T comparable](directedGraph graph.DirectedGraph[T], less func(lhs, rhs graph.Node[T]) bool) ([][]graph.Node[T], error) {
+	nodes, inDegrees := directedGraph.Nodes(), toInDegrees(directedGraph)
+	res, layer, count := make([][]graph.Node[T], 0), make([]graph.Node[T], 0), 0
+	for _, n := range nodes {
+		if inDegrees[n.Key()] == 0 {
+			layer = append(layer, n)
+		}
+	}
+
+	for len(layer) != 0 {
+		if less != nil {
+			sort.SliceStable(layer, func(i, j int) bool { return less(layer[i], layer[j]) })
+		}
+		res, count = append(res, layer), count+len(layer)
+		nextLayer := make([]graph.Node[T], 0)
+		for _, currentNode := range layer {
+			for _, child := range currentNode.Children() {
+				if inDegrees[child.Key()]--; inDegrees[child.Key()] == 0 {
+					nextLayer = append(nextLayer, child)
+				}
+			}
+		}
+		layer = nextLayer
+	}
+
+	if count != len(nodes) {
+		_, err := TopologicalSort(directedGraph)
+		return nil, err
+	}
+	return res, nil
+}
+
+func toInDegrees[T comparable](directedGraph graph.DirectedGraph[T]) map[T]int {
+	inDegrees := make(map[T]int)
+	for _, n := range directedGraph.Nodes() {
+		for _, child := range n.Children() {
+			inDegrees[child.Key()]++
+		}
+	}
+	return inDegrees
+}
@@ -0,0 +1,83 @@
+package graphutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+
+	"github.com/brmatvey/go-data-structs/slice"
+)
+
+func TestKahnSort(t *testing.T) {
+	t.Run("lexicographically smallest order", func(t *testing.T) {
+		graph, _ := branchedGraph()
+		for i := 0; i < 10; i++ {
+			sortedSeq, err := graphutil.KahnSort(graph, graphutil.ByKey[string])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slice.IsEqual(nodeKeys(sortedSeq), []string{"start", "eat", "commute", "smoking", "work"}) {
+				t.Fatal("inconsistent order")
+			}
+		}
+	})
+
+	t.Run("user priority", func(t *testing.T) {
+		directedGraph, _ := branchedGraph()
+		priority := map[string]int{"smoking": 0, "commute": 1, "eat": 2}
+		less := func(lhs, rhs graph.Node[string]) bool { return priority[lhs.Key()] < priority[rhs.Key()] }
+
+		sortedSeq, err := graphutil.KahnSort(directedGraph, less)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slice.IsEqual(nodeKeys(sortedSeq), []string{"start", "smoking", "eat", "commute", "work"}) {
+			t.Fatal("inconsistent order")
+		}
+	})
+
+	t.Run("circled sequence", func(t *testing.T) {
+		_, err := graphutil.KahnSort(generateLoopedGraph(), graphutil.ByKey[string])
+		var cycleErr *graphutil.CycleError[string]
+		if !errors.As(err, &cycleErr) {
+			t.Fatal("error must be CycleError")
+		}
+	})
+}
+
+func TestTopologicalLayers(t *testing.T) {
+	t.Run("branched struct", func(t *testing.T) {
+		graph, _ := branchedGraph()
+		layers, err := graphutil.TopologicalLayers(graph, graphutil.ByKey[string])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := [][]string{{"start"}, {"eat", "smoking"}, {"commute"}, {"work"}}
+		if len(layers) != len(expected) {
+			t.Fatal("inconsistent layers amount")
+		}
+		for i, layer := range layers {
+			if !slice.IsEqual(nodeKeys(layer), expected[i]) {
+				t.Fatal("inconsistent layer")
+			}
+		}
+	})
+
+	t.Run("circled sequence", func(t *testing.T) {
+		_, err := graphutil.TopologicalLayers(generateLoopedGraph(), nil)
+		if err == nil {
+			t.Fatal("err must be not nil")
+		}
+	})
+}
+
+func nodeKeys[T comparable](nodes []graph.Node[T]) []T {
+	keys := make([]T, len(nodes))
+	for i, n := range nodes {
+		keys[i] = n.Key()
+	}
+	return keys
+}
@@ -0,0 +1,184 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// Signed are weights which could be negative, costs of flow must be signed since backward arcs have opposite cost.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// CostFlow is result of min-cost flow algorithms.
+type CostFlow[K, T comparable, W Signed] struct {
+	*Flow[K, T, W]
+	cost W
+}
+
+func (c *CostFlow[K, T, W]) Cost() W { return c.cost }
+
+// FlowOption configures MinCostFlow only, so options of other algorithms can't be passed to it by mistake.
+type FlowOption[W Signed] func(*flowOptions[W])
+
+// WithFlowAmount makes min-cost flow send exactly given amount of flow instead of max flow.
+func WithFlowAmount[W Signed](amount W) FlowOption[W] {
+	return func(o *flowOptions[W]) {
+		o.amount, o.limited = amount, true
+	}
+}
+
+func WithNetworkSimplex[W Signed]() FlowOption[W] {
+	return func(o *flowOptions[W]) {
+		o.simplex = true
+	}
+}
+
+type flowOptions[W Signed] struct {
+	amount  W
+	limited bool
+	simplex bool
+}
+
+// MinCostFlow sends max flow from start to stop node with the lowest total cost, cost of edge is paid per unit of flow.
+// Pass WithFlowAmount option to send exactly required amount instead of max flow.
+// Edges of zero capacity carry no flow, so capacity function could exclude edges as well.
+// Successive shortest paths are used by default, they return NegativeCycleError if there is any cycle of negative cost in network. Network simplex is used with WithNetworkSimplex option.
+func MinCostFlow[K, T comparable, W Signed](start, stop T, weightedGraph graph.WeightedGraph[K, T, W], capacity, cost WeightFunc[K, T, W], opts ...FlowOption[W]) (*CostFlow[K, T, W], error) {
+	if err := checkNodes[T](weightedGraph, start, stop); err != nil {
+		return nil, err
+	}
+	o := &flowOptions[W]{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	n, err := newNetwork(weightedGraph, &options[K, T, W]{weight: capacity})
+	if err != nil {
+		return nil, err
+	}
+	costs := make([]W, len(n.edges))
+	for pair, e := range n.edges {
+		costs[pair] = cost(e)
+	}
+
+	s, t := n.index[start], n.index[stop]
+	var value W
+	if o.simplex {
+		value, err = networkSimplex(n, costs, s, t, o)
+	} else {
+		value, err = successiveShortestPaths(n, costs, s, t, o)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := &CostFlow[K, T, W]{Flow: newFlow(start, stop, value, n)}
+	for pair := range n.edges {
+		res.cost += n.flow(pair) * costs[pair]
+	}
+	return res, nil
+}
+
+// successiveShortestPaths augments flow along the cheapest paths. Potentials keep reduced costs non-negative, so Dijkstra is used.
+func successiveShortestPaths[K, T comparable, W Signed](n *network[K, T, W], costs []W, s, t int, o *flowOptions[W]) (W, error) {
+	arcCost := func(arc int) W {
+		if arc%2 == 1 {
+			return -costs[arc/2]
+		}
+		return costs[arc/2]
+	}
+
+	nodePotentials, err := costPotentials(n, costs)
+	if err != nil {
+		return 0, err
+	}
+
+	value := W(0)
+	for s != t && (!o.limited || value < o.amount) {
+		distances, parents := make([]W, len(n.keys)), make([]int, len(n.keys))
+		for i := range parents {
+			distances[i], parents[i] = graph.Infinity[W](), -1
+		}
+		distances[s] = 0
+		q := newPriorityQueue[int, W]()
+		q.Push(s, 0)
+		for !q.Empty() {
+			current, d := q.Pop()
+			if d > distances[current] {
+				continue
+			}
+			for _, arc := range n.adjacency[current] {
+				next := n.to[arc]
+				if n.residual[arc] <= 0 {
+					continue
+				}
+				reduced := arcCost(arc) + nodePotentials[current] - nodePotentials[next]
+				if reduced < 0 {
+					// rounding error, reduced costs are never negative
+					reduced = 0
+				}
+				if candidate := add(d, reduced); candidate < distances[next] {
+					distances[next], parents[next] = candidate, arc
+					q.Push(next, candidate)
+				}
+			}
+		}
+		if parents[t] < 0 {
+			break
+		}
+		for i, d := range distances {
+			if parents[i] >= 0 || i == s {
+				nodePotentials[i] += d
+			}
+		}
+
+		delta := n.residual[parents[t]]
+		if o.limited && o.amount-value < delta {
+			delta = o.amount - value
+		}
+		for current := t; current != s; current = n.to[parents[current]^1] {
+			if residual := n.residual[parents[current]]; residual < delta {
+				delta = residual
+			}
+		}
+		for current := t; current != s; current = n.to[parents[current]^1] {
+			n.push(parents[current], delta)
+		}
+		value = add(value, delta)
+	}
+
+	if o.limited && value < o.amount {
+		return 0, &InsufficientFlowError[W]{Required: o.amount, Max: value}
+	}
+	return value, nil
+}
+
+// costPotentials are costs of the cheapest paths from virtual node linked with every node by free arc, they are found by Bellman-Ford.
+// So negative cycles are found in the whole network, even if they aren't reachable from start node.
+func costPotentials[K, T comparable, W Signed](n *network[K, T, W], costs []W) ([]W, error) {
+	res, parents := make([]W, len(n.keys)), make(map[T]graph.Edge[K, T, W])
+	for i := 0; i <= len(n.keys); i++ {
+		relaxed := -1
+		for pair, e := range n.edges {
+			from, to := n.to[2*pair+1], n.to[2*pair]
+			if n.residual[2*pair] <= 0 {
+				continue
+			}
+			if candidate := res[from] + costs[pair]; candidate < res[to] {
+				res[to], parents[n.keys[to]] = candidate, e
+				relaxed = to
+			}
+		}
+		if relaxed < 0 {
+			break
+		}
+		if i == len(n.keys) {
+			edgeCosts := make(map[graph.Edge[K, T, W]]W, len(n.edges))
+			for pair, e := range n.edges {
+				edgeCosts[e] = costs[pair]
+			}
+			cost := func(e graph.Edge[K, T, W]) W { return edgeCosts[e] }
+			return nil, newNegativeCycleError(n.keys[relaxed], parents, len(n.keys), cost)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,179 @@
+package graphutil_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+var minCostFlowMethods = map[string][]graphutil.FlowOption[float64]{
+	"successive shortest paths": nil,
+	"network simplex":           {graphutil.WithNetworkSimplex[float64]()},
+}
+
+func TestMinCostFlow(t *testing.T) {
+	//     2, cost 1      1, cost 3
+	// 1 -> -> -> -> 2 -> -> -> -> 4
+	//  \            | 1, cost 1  /
+	//   \-> -> -> ->3 -> -> -> ->
+	//     1, cost 2    2, cost 1
+	cost := func(cost float64) graph.Attributes { return graph.Attributes{"cost": cost} }
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 2).WithAttributes(cost(1)), graph.NewLength(3, 1).WithAttributes(cost(2))},
+		2: {graph.NewLength(3, 1).WithAttributes(cost(1)), graph.NewLength(4, 1).WithAttributes(cost(3))},
+		3: {graph.NewLength(4, 2).WithAttributes(cost(1))},
+		4: {},
+	}
+	weightedGraph := newWeightedGraph(t, dependencies)
+	capacity, costs := graphutil.EdgeWeight[int, int, float64](), graphutil.AttributeWeight[int, int, float64]("cost")
+
+	for name, opts := range minCostFlowMethods {
+		t.Run(name, func(t *testing.T) {
+			flow, err := graphutil.MinCostFlow(1, 4, weightedGraph, capacity, costs, opts...)
+			if err != nil {
+				t.Fatal("error must be nil")
+			}
+			checkFlow(t, weightedGraph, flow.Flow, 3)
+			if flow.Cost() != 10 {
+				t.Fatalf("incorrect cost %v", flow.Cost())
+			}
+
+			flow, err = graphutil.MinCostFlow(1, 4, weightedGraph, capacity, costs, append(opts, graphutil.WithFlowAmount[float64](2))...)
+			if err != nil {
+				t.Fatal("error must be nil")
+			}
+			checkFlow(t, weightedGraph, flow.Flow, 2)
+			if flow.Cost() != 6 {
+				t.Fatalf("incorrect cost %v", flow.Cost())
+			}
+
+			_, err = graphutil.MinCostFlow(1, 4, weightedGraph, capacity, costs, append(opts, graphutil.WithFlowAmount[float64](4))...)
+			var insufficientFlowErr *graphutil.InsufficientFlowError[float64]
+			if !errors.Is(err, graphutil.ErrInfeasibleFlow) || !errors.As(err, &insufficientFlowErr) || insufficientFlowErr.Max != 3 {
+				t.Fatal("err must be insufficient flow error")
+			}
+		})
+	}
+
+	t.Run("options", func(t *testing.T) {
+		opts := make([]graphutil.FlowOption[float64], 1, 2)
+		opts[0] = graphutil.WithNetworkSimplex[float64]()
+		flow, err := graphutil.MinCostFlow(1, 4, weightedGraph, capacity, costs, append(opts[:1], graphutil.WithFlowAmount[float64](2))...)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if flow.Value() != 2 || flow.Cost() != 6 {
+			t.Fatal("options must be applied")
+		}
+	})
+
+	t.Run("negative cycle", func(t *testing.T) {
+		//   1, cost 1    2, cost 1
+		// 1 -> -> -> 2 -> -> -> -> 3
+		//            <- <- <- <- <-
+		//             1, cost -5
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 1).WithAttributes(cost(1))},
+			2: {graph.NewLength(3, 2).WithAttributes(cost(1))},
+			3: {graph.NewLength(2, 1).WithAttributes(cost(-5))},
+		}
+		weightedGraph := newWeightedGraph(t, dependencies)
+
+		flow, err := graphutil.MinCostFlow(1, 3, weightedGraph, capacity, costs, graphutil.WithNetworkSimplex[float64]())
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		checkFlow(t, weightedGraph, flow.Flow, 1)
+		if flow.Cost() != -2 {
+			t.Fatalf("incorrect cost %v", flow.Cost())
+		}
+
+		if _, err = graphutil.MinCostFlow(1, 3, weightedGraph, capacity, costs); !errors.Is(err, graphutil.ErrNegativeCycle) {
+			t.Fatal("successive shortest paths don't support negative cycles")
+		}
+
+		unlimited := func(e graph.Edge[int, int, float64]) float64 {
+			if e.From().Key() == 1 {
+				return 1
+			}
+			return math.Inf(1)
+		}
+		_, err = graphutil.MinCostFlow(1, 3, weightedGraph, unlimited, costs, graphutil.WithNetworkSimplex[float64]())
+		var negativeCycleErr *graphutil.NegativeCycleError[int, int, float64]
+		if !errors.As(err, &negativeCycleErr) || negativeCycleErr.Weight != -4 {
+			t.Fatal("err must be negative cycle error")
+		}
+	})
+
+	t.Run("unreachable negative cycle", func(t *testing.T) {
+		//   1, cost 1         1, cost 1
+		// 1 -> -> -> 2     3 -> -> -> 4
+		//                    <- <- <-
+		//                   1, cost -3
+		dependencies := map[int][]graph.Length[int, float64]{
+			1: {graph.NewLength(2, 1).WithAttributes(cost(1))},
+			3: {graph.NewLength(4, 1).WithAttributes(cost(1))},
+			4: {graph.NewLength(3, 1).WithAttributes(cost(-3))},
+		}
+		weightedGraph := newWeightedGraph(t, dependencies)
+
+		flow, err := graphutil.MinCostFlow(1, 2, weightedGraph, capacity, costs, graphutil.WithNetworkSimplex[float64]())
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if flow.Value() != 1 || flow.Cost() != -1 {
+			t.Fatalf("incorrect cost %v", flow.Cost())
+		}
+		var negativeCycleErr *graphutil.NegativeCycleError[int, int, float64]
+		if _, err = graphutil.MinCostFlow(1, 2, weightedGraph, capacity, costs); !errors.As(err, &negativeCycleErr) || negativeCycleErr.Weight != -2 {
+			t.Fatal("successive shortest paths must find negative cycle in the whole network")
+		}
+	})
+
+	t.Run("generated graphs", func(t *testing.T) {
+		keyCost := func(e graph.Edge[int, int, float64]) float64 { return float64(e.Key()%20 + 1) }
+		for seed := int64(1); seed <= 10; seed++ {
+			weightedGraph := generateNetwork(t, 20, 0.3, seed)
+			maxFlow, err := graphutil.Dinic(0, 19, weightedGraph)
+			if err != nil {
+				t.Fatal("error must be nil")
+			}
+			costs := make(map[string]float64)
+			for name, opts := range minCostFlowMethods {
+				flow, err := graphutil.MinCostFlow(0, 19, weightedGraph, capacity, keyCost, opts...)
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				checkFlow(t, weightedGraph, flow.Flow, maxFlow.Value())
+				costs[name] = flow.Cost()
+			}
+			if costs["successive shortest paths"] != costs["network simplex"] {
+				t.Fatalf("methods found different costs %v", costs)
+			}
+		}
+	})
+
+	t.Run("generated graphs with negative costs", func(t *testing.T) {
+		// cycles can't be negative: differences of node keys are canceled in them
+		keyCost := func(e graph.Edge[int, int, float64]) float64 {
+			return float64(e.To().Key() - e.From().Key() + e.Key()%5)
+		}
+		for seed := int64(1); seed <= 10; seed++ {
+			weightedGraph := generateNetwork(t, 20, 0.3, seed)
+			costs := make(map[string]float64)
+			for name, opts := range minCostFlowMethods {
+				flow, err := graphutil.MinCostFlow(0, 19, weightedGraph, capacity, keyCost, opts...)
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				costs[name] = flow.Cost()
+			}
+			if costs["successive shortest paths"] != costs["network simplex"] {
+				t.Fatalf("methods found different costs %v", costs)
+			}
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// Cut is partition of nodes into source side containing start node and sink side containing stop node.
+type Cut[K, T comparable, W graph.Weight] struct {
+	source   map[T]struct{}
+	edges    []graph.Edge[K, T, W]
+	capacity W
+}
+
+func (c *Cut[K, T, W]) SourceSide() map[T]struct{} {
+	source := make(map[T]struct{}, len(c.source))
+	for key := range c.source {
+		source[key] = struct{}{}
+	}
+	return source
+}
+
+// Edges returns edges from source side to sink side, undirected edges are oriented the same way.
+func (c *Cut[K, T, W]) Edges() []graph.Edge[K, T, W] {
+	return append([]graph.Edge[K, T, W](nil), c.edges...)
+}
+
+func (c *Cut[K, T, W]) Capacity() W { return c.capacity }
+
+// MinCut finds bottleneck between start and stop nodes, its capacity is equal to max flow value.
+func MinCut[K, T comparable, W graph.Weight](start, stop T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*Cut[K, T, W], error) {
+	if err := checkNodes[T](weightedGraph, start, stop); err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+	n, err := newNetwork(weightedGraph, o)
+	if err != nil {
+		return nil, err
+	}
+	dinic(n, n.index[start], n.index[stop])
+	return newCut(n, n.sourceSide(n.index[start]), o.weight), nil
+}
+
+func newCut[K, T comparable, W graph.Weight](n *network[K, T, W], side []bool, capacity WeightFunc[K, T, W]) *Cut[K, T, W] {
+	c := &Cut[K, T, W]{source: make(map[T]struct{}), edges: make([]graph.Edge[K, T, W], 0)}
+	for i, inSource := range side {
+		if inSource {
+			c.source[n.keys[i]] = struct{}{}
+		}
+	}
+	for pair, e := range n.edges {
+		if side[n.to[2*pair+1]] && !side[n.to[2*pair]] {
+			c.edges, c.capacity = append(c.edges, e), add(c.capacity, capacity(e))
+		}
+	}
+	return c
+}
@@ -0,0 +1,82 @@
+package graphutil_test
+
+import (
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestMinCut(t *testing.T) {
+	//      15       16      8
+	// 1 -> -> 2 -> -> 4 -> -> 7
+	// 1\             10\   5/  \10
+	//   \-> 3 -> 5 -> -\->-/    \-> 8
+	//      3 \  2       \ 3    /
+	//         \-> 6 -> -> -> ->
+	dependencies := map[int][]graph.Length[int, int]{
+		1: {graph.NewLengthOf(2, 15), graph.NewLengthOf(3, 1)},
+		2: {graph.NewLengthOf(4, 16)},
+		3: {graph.NewLengthOf(5, 3), graph.NewLengthOf(6, 2)},
+		4: {graph.NewLengthOf(7, 8), graph.NewLengthOf(6, 10)},
+		5: {graph.NewLengthOf(7, 5)},
+		6: {graph.NewLengthOf(8, 3)},
+		7: {graph.NewLengthOf(8, 10)},
+		8: {},
+	}
+	weightedGraph := newWeightedGraph(t, dependencies)
+
+	cut, err := graphutil.MinCut(1, 8, weightedGraph)
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	flow, err := graphutil.Dinic(1, 8, weightedGraph)
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	if cut.Capacity() != 12 || cut.Capacity() != flow.Value() {
+		t.Fatalf("incorrect cut capacity %v", cut.Capacity())
+	}
+
+	source, total := cut.SourceSide(), 0
+	if _, ok := source[1]; !ok {
+		t.Fatal("start must be on source side")
+	}
+	if _, ok := source[8]; ok {
+		t.Fatal("stop must be on sink side")
+	}
+	for _, e := range cut.Edges() {
+		_, fromSource := source[e.From().Key()]
+		_, toSource := source[e.To().Key()]
+		if !fromSource || toSource {
+			t.Fatalf("edge %v doesn't cross the cut", e.Key())
+		}
+		total += e.Weight()
+	}
+	if total != cut.Capacity() {
+		t.Fatal("capacity must be sum of cut edges")
+	}
+
+	t.Run("undirected graph", func(t *testing.T) {
+		//    3     2
+		// 1 --- 2 --- 4
+		//  \ 2  | 4  / 3
+		//   \-- 3 --/
+		count := 0
+		undirectedGraph, err := graph.NewUndirectedWeightedGraphFromCreator(graph.NewUndirectedWeightedGraphCreator(map[int][]graph.Length[int, int]{
+			1: {graph.NewLengthOf(2, 3), graph.NewLengthOf(3, 2)},
+			2: {graph.NewLengthOf(3, 4), graph.NewLengthOf(4, 2)},
+			3: {graph.NewLengthOf(4, 3)},
+		}, func() int { count++; return count }))
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		cut, err := graphutil.MinCut[int, int, int](1, 4, undirectedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		if cut.Capacity() != 5 || len(cut.Edges()) != 2 {
+			t.Fatalf("incorrect cut capacity %v", cut.Capacity())
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package graphutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/brmatvey/go-data-structs/slice"
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+type NegativeCycleError[K, T comparable, W graph.Weight] struct {
+	Cycle  []graph.Edge[K, T, W]
+	Weight W
+}
+
+func (e *NegativeCycleError[K, T, W]) Error() string {
+	keys := make([]string, 0, len(e.Cycle)+1)
+	for _, edge := range e.Cycle {
+		keys = append(keys, fmt.Sprint(edge.From().Key()))
+	}
+	if len(e.Cycle) > 0 {
+		keys = append(keys, fmt.Sprint(e.Cycle[0].From().Key()))
+	}
+	return fmt.Sprintf("negative circular dependencies in graph: %s", strings.Join(keys, " -> "))
+}
+
+func (e *NegativeCycleError[K, T, W]) Unwrap() error { return ErrNegativeCycle }
+
+// newNegativeCycleError walks predecessor edges from the node relaxed on the extra
+// iteration of Bellman-Ford. After nodesCount steps the walk is guaranteed to be on the cycle.
+func newNegativeCycleError[K, T comparable, W graph.Weight](relaxed T, edges map[T]graph.Edge[K, T, W], nodesCount int, weight WeightFunc[K, T, W]) *NegativeCycleError[K, T, W] {
+	current := relaxed
+	for i := 0; i < nodesCount; i++ {
+		current = edges[current].From().Key()
+	}
+
+	res := &NegativeCycleError[K, T, W]{Cycle: make([]graph.Edge[K, T, W], 0)}
+	for key := current; ; {
+		e := edges[key]
+		res.Cycle, res.Weight = append(res.Cycle, e), res.Weight+weight(e)
+		if key = e.From().Key(); key == current {
+			break
+		}
+	}
+	slice.Reverse(res.Cycle)
+	return res
+}
+
+// potentials are distances from virtual node connected to every node with zero weight
+func potentials[K, T comparable, W graph.Weight](nodes []graph.Node[T], edges []graph.Edge[K, T, W], weight WeightFunc[K, T, W]) (map[T]W, error) {
+	res, parents := make(map[T]W, len(nodes)), make(map[T]graph.Edge[K, T, W])
+	for _, n := range nodes {
+		res[n.Key()] = 0
+	}
+	for i := 0; i <= len(nodes); i++ {
+		relaxed := (*T)(nil)
+		for _, e := range edges {
+			if res[e.To().Key()] > res[e.From().Key()]+weight(e) {
+				res[e.To().Key()], parents[e.To().Key()] = res[e.From().Key()]+weight(e), e
+				key := e.To().Key()
+				relaxed = &key
+			}
+		}
+		if relaxed == nil {
+			break
+		}
+		if i == len(nodes) {
+			return nil, newNegativeCycleError(*relaxed, parents, len(nodes), weight)
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// network is residual network of flow algorithms. Every edge of graph is stored as pair of arcs:
+// forward arc 2*i with residual capacity and backward arc 2*i+1 whose residual capacity is the flow.
+type network[K, T comparable, W graph.Weight] struct {
+	keys      []T
+	index     map[T]int
+	edges     []graph.Edge[K, T, W]
+	to        []int
+	residual  []W
+	adjacency [][]int
+}
+
+func newNetwork[K, T comparable, W graph.Weight](weightedGraph graph.WeightedGraph[K, T, W], o *options[K, T, W]) (*network[K, T, W], error) {
+	nodes := weightedGraph.Nodes()
+	n := &network[K, T, W]{
+		keys:      make([]T, 0, len(nodes)),
+		index:     make(map[T]int, len(nodes)),
+		adjacency: make([][]int, len(nodes)),
+	}
+	for i, node := range nodes {
+		n.keys, n.index[node.Key()] = append(n.keys, node.Key()), i
+	}
+	for _, e := range o.arcs(weightedGraph) {
+		capacity := o.weight(e)
+		if capacity < 0 {
+			return nil, &NegativeWeightError{Key: e.Key(), From: e.From().Key(), To: e.To().Key(), Weight: capacity}
+		}
+		n.addArcs(n.index[e.From().Key()], n.index[e.To().Key()], capacity, e)
+	}
+	return n, nil
+}
+
+func (n *network[K, T, W]) addArcs(from, to int, capacity W, e graph.Edge[K, T, W]) {
+	n.edges = append(n.edges, e)
+	n.adjacency[from] = append(n.adjacency[from], len(n.to))
+	n.to, n.residual = append(n.to, to), append(n.residual, capacity)
+	n.adjacency[to] = append(n.adjacency[to], len(n.to))
+	n.to, n.residual = append(n.to, from), append(n.residual, 0)
+}
+
+// addNode adds auxiliary node without key, it has no edges of original graph.
+func (n *network[K, T, W]) addNode() int {
+	n.adjacency = append(n.adjacency, nil)
+	return len(n.adjacency) - 1
+}
+
+func (n *network[K, T, W]) push(arc int, delta W) {
+	n.residual[arc] -= delta
+	n.residual[arc^1] = add(n.residual[arc^1], delta)
+}
+
+func (n *network[K, T, W]) flow(pair int) W { return n.residual[2*pair+1] }
+
+// sourceSide returns nodes reachable from start via arcs with positive residual capacity.
+func (n *network[K, T, W]) sourceSide(start int) []bool {
+	visited := make([]bool, len(n.adjacency))
+	visited[start] = true
+	for queue := []int{start}; len(queue) > 0; queue = queue[1:] {
+		for _, arc := range n.adjacency[queue[0]] {
+			if next := n.to[arc]; n.residual[arc] > 0 && !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return visited
+}
+
+// residualGraph merges arcs passing the same edge in the same direction.
+func (n *network[K, T, W]) residualGraph() graph.WeightedGraph[ResidualKey[K, T], T, W] {
+	capacities, order := make(map[ResidualKey[K, T]]W), make([]ResidualKey[K, T], 0)
+	for arc, residual := range n.residual {
+		e := n.edges[arc/2]
+		key := ResidualKey[K, T]{Edge: e.Key(), From: e.From().Key(), To: e.To().Key()}
+		if arc%2 == 1 {
+			key.From, key.To = key.To, key.From
+		}
+		if _, ok := capacities[key]; !ok {
+			order = append(order, key)
+		}
+		capacities[key] = add(capacities[key], residual)
+	}
+
+	nodesMap, linked := make(map[T]graph.Node[T], len(n.keys)), make(map[path[T]]struct{})
+	nodes, edges := make([]graph.Node[T], 0, len(n.keys)), make([]graph.Edge[ResidualKey[K, T], T, W], 0)
+	for _, key := range n.keys {
+		nodesMap[key] = graph.NewNode(key)
+		nodes = append(nodes, nodesMap[key])
+	}
+	for _, key := range order {
+		if capacities[key] <= 0 {
+			continue
+		}
+		if _, ok := linked[newPath(key.From, key.To)]; !ok {
+			linked[newPath(key.From, key.To)] = struct{}{}
+			nodesMap[key.From].AddChildren(nodesMap[key.To])
+		}
+		edges = append(edges, graph.NewEdgeOf(key, capacities[key], nodesMap[key.From], nodesMap[key.To]))
+	}
+	// keys of residual edges are unique and every link has edge, so graph is always valid
+	residual, _ := graph.NewWeightedMultigraph(nodes, edges)
+	return residual
+}
+
+// reset removes flow from network.
+func (n *network[K, T, W]) reset() {
+	for arc := 0; arc < len(n.residual); arc += 2 {
+		n.residual[arc], n.residual[arc+1] = add(n.residual[arc], n.residual[arc+1]), 0
+	}
+}
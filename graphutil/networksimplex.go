@@ -0,0 +1,226 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// networkSimplex is primal network simplex. Initial spanning tree consists of artificial arcs between every node and
+// artificial root with big cost, strongly feasible trees are kept to avoid cycling on degenerate pivots.
+// Negative cycles of limited capacity are supported.
+func networkSimplex[K, T comparable, W Signed](n *network[K, T, W], costs []W, s, t int, o *flowOptions[W]) (W, error) {
+	maxFlow := dinic(n, s, t)
+	n.reset()
+	amount := maxFlow
+	if o.limited {
+		if o.amount > maxFlow {
+			return 0, &InsufficientFlowError[W]{Required: o.amount, Max: maxFlow}
+		}
+		amount = o.amount
+	}
+
+	x := newSimplex(n, costs, s, t, amount)
+	if cycle := x.solve(); cycle != nil {
+		res := &NegativeCycleError[K, T, W]{Cycle: make([]graph.Edge[K, T, W], 0, len(cycle))}
+		for _, arc := range cycle {
+			res.Cycle, res.Weight = append(res.Cycle, n.edges[arc]), res.Weight+costs[arc]
+		}
+		return 0, res
+	}
+	for pair := range n.edges {
+		if x.flow[pair] > 0 {
+			n.push(2*pair, x.flow[pair])
+		}
+	}
+	if s == t {
+		return 0, nil
+	}
+	return amount, nil
+}
+
+type simplex[W Signed] struct {
+	from, to             []int
+	capacity, cost, flow []W
+	inTree               []bool
+	root                 int
+	parent, parentArc    []int
+	depth                []int
+	potentials           []W
+	treeAdjacency        [][]int
+	next                 int
+}
+
+type simplexStep struct {
+	arc     int
+	forward bool
+}
+
+func newSimplex[K, T comparable, W Signed](n *network[K, T, W], costs []W, s, t int, amount W) *simplex[W] {
+	root := len(n.keys)
+	x := &simplex[W]{root: root}
+	bigCost := W(1)
+	for pair := range n.edges {
+		x.from, x.to = append(x.from, n.to[2*pair+1]), append(x.to, n.to[2*pair])
+		x.capacity, x.cost = append(x.capacity, n.residual[2*pair]), append(x.cost, costs[pair])
+		x.flow, x.inTree = append(x.flow, 0), append(x.inTree, false)
+		if costs[pair] < 0 {
+			bigCost -= costs[pair]
+		} else {
+			bigCost += costs[pair]
+		}
+	}
+
+	supplies := make([]W, root)
+	if s != t {
+		supplies[s], supplies[t] = amount, -amount
+	}
+	for v, supply := range supplies {
+		if supply >= 0 {
+			x.from, x.to, x.flow = append(x.from, v), append(x.to, root), append(x.flow, supply)
+		} else {
+			x.from, x.to, x.flow = append(x.from, root), append(x.to, v), append(x.flow, -supply)
+		}
+		x.capacity, x.cost, x.inTree = append(x.capacity, graph.Infinity[W]()), append(x.cost, bigCost), append(x.inTree, true)
+	}
+
+	x.parent, x.parentArc = make([]int, root+1), make([]int, root+1)
+	x.depth, x.potentials = make([]int, root+1), make([]W, root+1)
+	x.treeAdjacency = make([][]int, root+1)
+	return x
+}
+
+// solve pivots until there is no arc violating optimality. Arcs of unbounded negative cycle are returned if any.
+func (x *simplex[W]) solve() []int {
+	for {
+		x.buildTree()
+		entering := x.entering()
+		if entering < 0 {
+			return nil
+		}
+		cycle := x.cycle(entering)
+
+		delta, leaving := graph.Infinity[W](), -1
+		for i, step := range cycle {
+			if residual, ok := x.residual(step); ok && residual <= delta {
+				delta, leaving = residual, i
+			}
+		}
+		if leaving < 0 {
+			arcs := make([]int, 0, len(cycle))
+			for _, step := range cycle {
+				arcs = append(arcs, step.arc)
+			}
+			return arcs
+		}
+
+		for _, step := range cycle {
+			if step.forward {
+				x.flow[step.arc] += delta
+			} else {
+				x.flow[step.arc] -= delta
+			}
+		}
+		x.inTree[entering], x.inTree[cycle[leaving].arc] = true, false
+	}
+}
+
+// residual is amount of flow which could be pushed through arc, it isn't limited for arcs of infinite capacity.
+func (x *simplex[W]) residual(step simplexStep) (W, bool) {
+	if !step.forward {
+		return x.flow[step.arc], true
+	}
+	if x.capacity[step.arc] == graph.Infinity[W]() {
+		return 0, false
+	}
+	return x.capacity[step.arc] - x.flow[step.arc], true
+}
+
+func (x *simplex[W]) buildTree() {
+	for v := range x.treeAdjacency {
+		x.treeAdjacency[v] = x.treeAdjacency[v][:0]
+	}
+	for arc, inTree := range x.inTree {
+		if inTree {
+			x.treeAdjacency[x.from[arc]] = append(x.treeAdjacency[x.from[arc]], arc)
+			x.treeAdjacency[x.to[arc]] = append(x.treeAdjacency[x.to[arc]], arc)
+		}
+	}
+
+	x.parent[x.root], x.parentArc[x.root], x.depth[x.root], x.potentials[x.root] = -1, -1, 0, 0
+	for stack := []int{x.root}; len(stack) > 0; {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, arc := range x.treeAdjacency[current] {
+			if arc == x.parentArc[current] {
+				continue
+			}
+			// reduced cost of tree arc is zero
+			child := x.from[arc]
+			if child == current {
+				child = x.to[arc]
+				x.potentials[child] = x.potentials[current] + x.cost[arc]
+			} else {
+				x.potentials[child] = x.potentials[current] - x.cost[arc]
+			}
+			x.parent[child], x.parentArc[child], x.depth[child] = current, arc, x.depth[current]+1
+			stack = append(stack, child)
+		}
+	}
+}
+
+// entering returns arc violating optimality, search continues from the previous entering arc.
+func (x *simplex[W]) entering() int {
+	for i := 0; i < len(x.from); i++ {
+		arc := (x.next + i) % len(x.from)
+		if x.inTree[arc] || x.capacity[arc] == 0 {
+			continue
+		}
+		reduced := x.cost[arc] + x.potentials[x.from[arc]] - x.potentials[x.to[arc]]
+		if (x.flow[arc] == 0 && reduced < 0) || (x.flow[arc] == x.capacity[arc] && reduced > 0) {
+			x.next = arc + 1
+			return arc
+		}
+	}
+	return -1
+}
+
+// cycle returns arcs of cycle closed by entering arc, it starts from the join of tree paths and is oriented along entering arc
+// when arc has no flow and against it otherwise.
+func (x *simplex[W]) cycle(entering int) []simplexStep {
+	forward := x.flow[entering] == 0
+	first, second := x.from[entering], x.to[entering]
+	if !forward {
+		first, second = second, first
+	}
+
+	join := x.join(first, second)
+	res := make([]simplexStep, 0)
+	for v := first; v != join; v = x.parent[v] {
+		// flow goes down from parent
+		arc := x.parentArc[v]
+		res = append(res, simplexStep{arc: arc, forward: x.from[arc] == x.parent[v]})
+	}
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	res = append(res, simplexStep{arc: entering, forward: forward})
+	for v := second; v != join; v = x.parent[v] {
+		// flow goes up to parent
+		arc := x.parentArc[v]
+		res = append(res, simplexStep{arc: arc, forward: x.from[arc] == v})
+	}
+	return res
+}
+
+func (x *simplex[W]) join(first, second int) int {
+	for first != second {
+		switch {
+		case x.depth[first] > x.depth[second]:
+			first = x.parent[first]
+		case x.depth[second] > x.depth[first]:
+			second = x.parent[second]
+		default:
+			first, second = x.parent[first], x.parent[second]
+		}
+	}
+	return first
+}
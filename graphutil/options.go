@@ -0,0 +1,76 @@
+package graphutil
+
+import "github.com/brmatvey/go-graphs/graph"
+
+type Option[K, T comparable, W graph.Weight] func(*options[K, T, W])
+
+func WithTargets[K, T comparable, W graph.Weight](targets ...T) Option[K, T, W] {
+	return func(o *options[K, T, W]) {
+		if o.targets == nil {
+			o.targets = make(map[T]struct{}, len(targets))
+		}
+		for _, target := range targets {
+			o.targets[target] = struct{}{}
+		}
+	}
+}
+
+func WithDebug[K, T comparable, W graph.Weight]() Option[K, T, W] {
+	return func(o *options[K, T, W]) {
+		o.debug = true
+	}
+}
+
+func WithWeight[K, T comparable, W graph.Weight](weight WeightFunc[K, T, W]) Option[K, T, W] {
+	return func(o *options[K, T, W]) {
+		o.weight = weight
+	}
+}
+
+// WithEdgeFilter excludes edges for which filter returns false, graph itself is not changed.
+func WithEdgeFilter[K, T comparable, W graph.Weight](filter func(graph.Edge[K, T, W]) bool) Option[K, T, W] {
+	return func(o *options[K, T, W]) {
+		o.filter = filter
+	}
+}
+
+func newOptions[K, T comparable, W graph.Weight](opts []Option[K, T, W]) *options[K, T, W] {
+	o := &options[K, T, W]{weight: EdgeWeight[K, T, W]()}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+type options[K, T comparable, W graph.Weight] struct {
+	targets map[T]struct{}
+	debug   bool
+	weight  WeightFunc[K, T, W]
+	filter  func(graph.Edge[K, T, W]) bool
+}
+
+func (o *options[K, T, W]) outEdges(g graph.WeightedGraph[K, T, W]) func(T) []graph.Edge[K, T, W] {
+	if o.filter == nil {
+		return g.OutEdges
+	}
+	return func(key T) []graph.Edge[K, T, W] {
+		return o.filtered(g.OutEdges(key))
+	}
+}
+
+func (o *options[K, T, W]) arcs(g graph.WeightedGraph[K, T, W]) []graph.Edge[K, T, W] {
+	return o.filtered(arcs(g))
+}
+
+func (o *options[K, T, W]) filtered(edges []graph.Edge[K, T, W]) []graph.Edge[K, T, W] {
+	if o.filter == nil {
+		return edges
+	}
+	res := make([]graph.Edge[K, T, W], 0, len(edges))
+	for _, e := range edges {
+		if o.filter(e) {
+			res = append(res, e)
+		}
+	}
+	return res
+}
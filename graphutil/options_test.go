@@ -0,0 +1,78 @@
+package graphutil_test
+
+import (
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestCostModels(t *testing.T) {
+	//   distance 1, time 10
+	// 1 -> -> -> -> -> -> 2
+	//  \                 /
+	//   \-> 3 -> -> -> ->
+	//  distance 2, time 2 each
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 1).WithAttributes(graph.Attributes{"time": 10}), graph.NewLength(3, 2).WithAttributes(graph.Attributes{"time": 2})},
+		2: {},
+		3: {graph.NewLength(2, 2).WithAttributes(graph.Attributes{"time": 2, "closed": true})},
+	}
+	weightedGraph := newWeightedGraph(t, dependencies)
+	byTime := graphutil.WithWeight(graphutil.AttributeWeight[int, int, float64]("time"))
+	opened := graphutil.WithEdgeFilter(func(e graph.Edge[int, int, float64]) bool {
+		return e.Attributes()["closed"] != true
+	})
+
+	shortestPaths := map[string]func(int, graph.WeightedGraph[int, int, float64], ...graphutil.Option[int, int, float64]) (map[int]float64, error){
+		"dijkstra":     graphutil.Dijkstra[int, int, float64],
+		"bellman-ford": graphutil.BellmanFord[int, int, float64],
+		"floyd-warshall": func(start int, g graph.WeightedGraph[int, int, float64], opts ...graphutil.Option[int, int, float64]) (map[int]float64, error) {
+			paths, err := graphutil.FloydWarshall(g, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return paths.Distances()[start], nil
+		},
+		"johnson": func(start int, g graph.WeightedGraph[int, int, float64], opts ...graphutil.Option[int, int, float64]) (map[int]float64, error) {
+			paths, err := graphutil.Johnson(g, opts...)
+			if err != nil {
+				return nil, err
+			}
+			return paths.Distances()[start], nil
+		},
+	}
+
+	for name, algorithm := range shortestPaths {
+		t.Run(name, func(t *testing.T) {
+			for _, tc := range []struct {
+				opts     []graphutil.Option[int, int, float64]
+				expected float64
+			}{
+				{expected: 1},
+				{opts: []graphutil.Option[int, int, float64]{byTime}, expected: 4},
+				{opts: []graphutil.Option[int, int, float64]{byTime, opened}, expected: 10},
+			} {
+				lengths, err := algorithm(1, weightedGraph, tc.opts...)
+				if err != nil {
+					t.Fatal("error must be nil")
+				}
+				if lengths[2] != tc.expected {
+					t.Fatalf("incorrect length %v, expected %v", lengths[2], tc.expected)
+				}
+			}
+		})
+	}
+
+	t.Run("ford-fulkerson", func(t *testing.T) {
+		if flow, _ := graphutil.FordFulkerson(1, 2, weightedGraph); flow != 3 {
+			t.Fatalf("incorrect flow %v", flow)
+		}
+		if flow, _ := graphutil.FordFulkerson(1, 2, weightedGraph, byTime); flow != 12 {
+			t.Fatalf("incorrect flow %v", flow)
+		}
+		if flow, _ := graphutil.FordFulkerson(1, 2, weightedGraph, byTime, opened); flow != 10 {
+			t.Fatalf("incorrect flow %v", flow)
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package graphutil
+
+import "github.com/brmatvey/go-graphs/graph"
+
+func newBinaryHeap[T any](less func(lhs, rhs T) bool) *binaryHeap[T] {
+	return &binaryHeap[T]{items: make([]T, 0), less: less}
+}
+
+type binaryHeap[T any] struct {
+	items []T
+	less  func(lhs, rhs T) bool
+}
+
+func (h *binaryHeap[T]) Len() int    { return len(h.items) }
+func (h *binaryHeap[T]) Empty() bool { return len(h.items) == 0 }
+
+func (h *binaryHeap[T]) Push(value T) {
+	h.items = append(h.items, value)
+	for i := len(h.items) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !h.less(h.items[i], h.items[parent]) {
+			break
+		}
+		h.items[parent], h.items[i] = h.items[i], h.items[parent]
+		i = parent
+	}
+}
+
+func (h *binaryHeap[T]) Pop() T {
+	top, last := h.items[0], len(h.items)-1
+	h.items[0] = h.items[last]
+	h.items = h.items[:last]
+	for i := 0; ; {
+		smallest, left, right := i, 2*i+1, 2*i+2
+		if left < last && h.less(h.items[left], h.items[smallest]) {
+			smallest = left
+		}
+		if right < last && h.less(h.items[right], h.items[smallest]) {
+			smallest = right
+		}
+		if smallest == i {
+			break
+		}
+		h.items[smallest], h.items[i] = h.items[i], h.items[smallest]
+		i = smallest
+	}
+	return top
+}
+
+func newPriorityQueue[T any, W graph.Weight]() *priorityQueue[T, W] {
+	return &priorityQueue[T, W]{
+		heap: newBinaryHeap(func(lhs, rhs priorityItem[T, W]) bool { return lhs.priority < rhs.priority }),
+	}
+}
+
+type priorityItem[T any, W graph.Weight] struct {
+	value    T
+	priority W
+}
+
+type priorityQueue[T any, W graph.Weight] struct {
+	heap *binaryHeap[priorityItem[T, W]]
+}
+
+func (q *priorityQueue[T, W]) Len() int    { return q.heap.Len() }
+func (q *priorityQueue[T, W]) Empty() bool { return q.heap.Empty() }
+
+func (q *priorityQueue[T, W]) Push(value T, priority W) {
+	q.heap.Push(priorityItem[T, W]{value: value, priority: priority})
+}
+
+func (q *priorityQueue[T, W]) Pop() (T, W) {
+	item := q.heap.Pop()
+	return item.value, item.priority
+}
@@ -0,0 +1,158 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// PushRelabel always discharges node with the highest label. Gap heuristic lifts nodes which can't reach stop node anymore
+// and global relabeling recomputes labels as exact distances from time to time, it works in O(V^2 * sqrt(E)).
+func PushRelabel[K, T comparable, W graph.Weight](start, stop T, weightedGraph graph.WeightedGraph[K, T, W], opts ...Option[K, T, W]) (*Flow[K, T, W], error) {
+	if err := checkNodes[T](weightedGraph, start, stop); err != nil {
+		return nil, err
+	}
+	n, err := newNetwork(weightedGraph, newOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return newFlow(start, stop, pushRelabel(n, n.index[start], n.index[stop]), n), nil
+}
+
+func pushRelabel[K, T comparable, W graph.Weight](n *network[K, T, W], s, t int) W {
+	count := len(n.keys)
+	if s == t {
+		return 0
+	}
+	// labels of nodes which reach neither stop nor start node
+	unlabeled := 2 * count
+
+	heights, excess, current := make([]int, count), make([]W, count), make([]int, count)
+	counts, buckets, highest := make([]int, unlabeled+1), make([][]int, unlabeled), 0
+
+	activate := func(v int) {
+		if v == s || v == t || heights[v] >= unlabeled {
+			return
+		}
+		buckets[heights[v]] = append(buckets[heights[v]], v)
+		if heights[v] > highest {
+			highest = heights[v]
+		}
+	}
+
+	bfs := func(root int) {
+		for queue := []int{root}; len(queue) > 0; queue = queue[1:] {
+			for _, arc := range n.adjacency[queue[0]] {
+				if v := n.to[arc]; n.residual[arc^1] > 0 && heights[v] == unlabeled {
+					heights[v] = heights[queue[0]] + 1
+					queue = append(queue, v)
+				}
+			}
+		}
+	}
+
+	globalRelabel := func() {
+		for v := range heights {
+			heights[v], current[v] = unlabeled, 0
+		}
+		heights[s], heights[t] = count, 0
+		bfs(t)
+		bfs(s)
+		for h := range counts {
+			counts[h] = 0
+		}
+		for h := range buckets {
+			buckets[h] = buckets[h][:0]
+		}
+		highest = 0
+		for v := range heights {
+			counts[heights[v]]++
+			if excess[v] > 0 {
+				activate(v)
+			}
+		}
+	}
+
+	// gap: nodes above empty label can't reach stop node, so they only return excess to start node
+	gap := func(empty int) {
+		for v := range heights {
+			if heights[v] > empty && heights[v] < count {
+				counts[heights[v]]--
+				heights[v], current[v] = count+1, 0
+				counts[heights[v]]++
+				if excess[v] > 0 {
+					activate(v)
+				}
+			}
+		}
+	}
+
+	relabels := 0
+	relabel := func(u int) {
+		old, height := heights[u], unlabeled
+		for _, arc := range n.adjacency[u] {
+			if n.residual[arc] > 0 && heights[n.to[arc]]+1 < height {
+				height = heights[n.to[arc]] + 1
+			}
+		}
+		counts[old]--
+		heights[u], current[u] = height, 0
+		counts[height]++
+		if relabels++; old < count && counts[old] == 0 {
+			gap(old)
+		}
+	}
+
+	discharge := func(u int) {
+		for excess[u] > 0 && heights[u] < unlabeled {
+			if current[u] == len(n.adjacency[u]) {
+				relabel(u)
+				continue
+			}
+			arc := n.adjacency[u][current[u]]
+			v := n.to[arc]
+			if n.residual[arc] <= 0 || heights[u] != heights[v]+1 {
+				current[u]++
+				continue
+			}
+			delta := excess[u]
+			if n.residual[arc] < delta {
+				delta = n.residual[arc]
+			}
+			n.push(arc, delta)
+			excess[u] -= delta
+			if excess[v] == 0 {
+				activate(v)
+			}
+			excess[v] = add(excess[v], delta)
+		}
+	}
+
+	heights[s] = count
+	for _, arc := range n.adjacency[s] {
+		if v := n.to[arc]; v != s && n.residual[arc] > 0 {
+			excess[v] = add(excess[v], n.residual[arc])
+			n.push(arc, n.residual[arc])
+		}
+	}
+	globalRelabel()
+
+	for highest >= 0 {
+		bucket := buckets[highest]
+		if len(bucket) == 0 {
+			highest--
+			continue
+		}
+		u := bucket[len(bucket)-1]
+		buckets[highest] = bucket[:len(bucket)-1]
+		if heights[u] != highest || excess[u] <= 0 {
+			// node was lifted or discharged after it had been activated
+			continue
+		}
+		discharge(u)
+		if relabels >= count {
+			globalRelabel()
+			relabels = 0
+		}
+	}
+
+	return excess[t]
+}
@@ -0,0 +1,75 @@
+package graphutil_test
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestPushRelabelOnGeneratedGraphs(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		weightedGraph := generateNetwork(t, 30, 0.2, seed)
+		expected, err := graphutil.FordFulkerson(0, 29, weightedGraph)
+		if err != nil {
+			t.Fatal("error must be nil")
+		}
+		for name, algorithm := range maxFlowAlgorithms {
+			flow, err := algorithm(0, 29, weightedGraph)
+			if err != nil {
+				t.Fatal("error must be nil")
+			}
+			if flow.Value() != expected {
+				t.Fatalf("%s: incorrect flow %v, expected %v", name, flow.Value(), expected)
+			}
+			checkFlow(t, weightedGraph, flow, expected)
+		}
+	}
+}
+
+func BenchmarkMaxFlow(b *testing.B) {
+	algorithms := map[string]maxFlowAlgorithm{
+		"ford-fulkerson": func(start, stop int, g graph.WeightedGraph[int, int, float64], opts ...graphutil.Option[int, int, float64]) (*graphutil.Flow[int, int, float64], error) {
+			_, err := graphutil.FordFulkerson(start, stop, g, opts...)
+			return nil, err
+		},
+	}
+	for name, algorithm := range maxFlowAlgorithms {
+		algorithms[name] = algorithm
+	}
+
+	for _, size := range []int{50, 200} {
+		weightedGraph := generateNetwork(b, size, 0.5, 1)
+		for name, algorithm := range algorithms {
+			b.Run(name+"/"+strconv.Itoa(size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					if _, err := algorithm(0, size-1, weightedGraph); err != nil {
+						b.Fatal("error must be nil")
+					}
+				}
+			})
+		}
+	}
+}
+
+// generateNetwork links every pair of nodes with given probability, capacities are integers from 1 to 100
+func generateNetwork(tb testing.TB, size int, density float64, seed int64) graph.WeightedGraph[int, int, float64] {
+	random, dependencies := rand.New(rand.NewSource(seed)), make(map[int][]graph.Length[int, float64], size)
+	for from := 0; from < size; from++ {
+		dependencies[from] = make([]graph.Length[int, float64], 0)
+		for to := 0; to < size; to++ {
+			if from != to && random.Float64() < density {
+				dependencies[from] = append(dependencies[from], graph.NewLength(to, float64(1+random.Intn(100))))
+			}
+		}
+	}
+
+	count := 0
+	weightedGraph, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, func() int { count++; return count }))
+	if err != nil {
+		tb.Fatal("error must be nil")
+	}
+	return weightedGraph
+}
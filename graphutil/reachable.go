@@ -0,0 +1,25 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// Reachable returns keys of nodes reachable from start including start itself.
+func Reachable[T comparable](start T, directedGraph graph.DirectedGraph[T]) (map[T]struct{}, error) {
+	if err := checkNodes(directedGraph, start); err != nil {
+		return nil, err
+	}
+	startNode, _ := directedGraph.Node(start)
+	res, queue := map[T]struct{}{start: {}}, []graph.Node[T]{startNode}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range current.Children() {
+			if _, ok := res[child.Key()]; !ok {
+				res[child.Key()] = struct{}{}
+				queue = append(queue, child)
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package graphutil_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestReachable(t *testing.T) {
+	// 1 -> 2 -> 3    4 -> 1
+	directedGraph, err := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(map[int][]int{
+		1: {2},
+		2: {3},
+		3: {},
+		4: {1},
+	}))
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+
+	reachable, err := graphutil.Reachable(1, directedGraph)
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	if _, ok := reachable[4]; ok || len(reachable) != 3 {
+		t.Fatalf("incorrect reachable nodes %v", reachable)
+	}
+
+	if _, err = graphutil.Reachable(5, directedGraph); !errors.Is(err, graph.ErrUnknownNode) {
+		t.Fatal("err must be unknown node error")
+	}
+}
+
+func TestUnknownNodes(t *testing.T) {
+	weightedGraph := newWeightedGraph(t, map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 1)},
+		2: {},
+	})
+	zero := func(int) float64 { return 0 }
+
+	checks := map[string]func() error{
+		"dijkstra": func() error {
+			_, err := graphutil.Dijkstra(3, weightedGraph)
+			return err
+		},
+		"bellman-ford": func() error {
+			_, err := graphutil.BellmanFord(3, weightedGraph)
+			return err
+		},
+		"a*": func() error {
+			_, _, err := graphutil.AStar(1, 3, weightedGraph, zero)
+			return err
+		},
+		"check admissible": func() error {
+			return graphutil.CheckAdmissible(3, weightedGraph, zero)
+		},
+		"ford-fulkerson": func() error {
+			_, err := graphutil.FordFulkerson(1, 3, weightedGraph)
+			return err
+		},
+		"dijkstra targets": func() error {
+			_, err := graphutil.Dijkstra(1, weightedGraph, graphutil.WithTargets[int, int, float64](2, 3))
+			return err
+		},
+		"path to": func() error {
+			paths, err := graphutil.DijkstraPaths(1, weightedGraph)
+			if err != nil {
+				return err
+			}
+			_, _, err = paths.PathTo(3)
+			return err
+		},
+		"all pairs path": func() error {
+			paths, err := graphutil.FloydWarshall(weightedGraph)
+			if err != nil {
+				return err
+			}
+			_, _, err = paths.Path(3, 1)
+			return err
+		},
+	}
+	for name, check := range checks {
+		t.Run(name, func(t *testing.T) {
+			var unknownNodeErr *graph.UnknownNodeError
+			if err := check(); !errors.As(err, &unknownNodeErr) || unknownNodeErr.Key != 3 {
+				t.Fatal("err must be unknown node error")
+			}
+		})
+	}
+}
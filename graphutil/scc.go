@@ -0,0 +1,147 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-graphs/graph"
+
+	"github.com/brmatvey/go-data-structs/slice"
+	"github.com/brmatvey/go-data-structs/stack"
+)
+
+func Tarjan[T comparable](directedGraph graph.DirectedGraph[T]) [][]graph.Node[T] {
+	return tarjan(directedGraph.Nodes(), func(T) bool { return true })
+}
+
+func Kosaraju[T comparable](directedGraph graph.DirectedGraph[T]) [][]graph.Node[T] {
+	nodes := directedGraph.Nodes()
+
+	// nodes in order of finishing dfs
+	order, colors := make([]graph.Node[T], 0, len(nodes)), make(map[T]int)
+	for _, nod := range nodes {
+		s := stack.New[graph.Node[T]]()
+		s.Push(nod)
+		for !s.Empty() {
+			currentNode := s.Peek()
+			switch colors[currentNode.Key()] {
+			case 0:
+				colors[currentNode.Key()] = 1
+				for _, child := range currentNode.Children() {
+					if colors[child.Key()] == 0 {
+						s.Push(child)
+					}
+				}
+			case 1:
+				colors[currentNode.Key()] = 2
+				order = append(order, currentNode)
+				s.Pop()
+			case 2:
+				s.Pop()
+			}
+		}
+	}
+	slice.Reverse(order)
+
+	res, assigned := make([][]graph.Node[T], 0), make(map[T]bool)
+	for _, nod := range order {
+		if assigned[nod.Key()] {
+			continue
+		}
+		component, s := make([]graph.Node[T], 0), stack.New[graph.Node[T]]()
+		s.Push(nod)
+		assigned[nod.Key()] = true
+		for !s.Empty() {
+			currentNode := s.Peek()
+			s.Pop()
+			component = append(component, currentNode)
+			for _, parent := range directedGraph.Parents(currentNode.Key()) {
+				if !assigned[parent.Key()] {
+					assigned[parent.Key()] = true
+					s.Push(parent)
+				}
+			}
+		}
+		res = append(res, component)
+	}
+	return res
+}
+
+// Condensation collapses every strongly connected component into single node. Keys of new graph
+// are indexes of returned components, which are numbered in topological order.
+func Condensation[T comparable](directedGraph graph.DirectedGraph[T]) (graph.DirectedGraph[int], [][]graph.Node[T], error) {
+	components := Tarjan(directedGraph)
+	slice.Reverse(components)
+
+	indexes := make(map[T]int)
+	for i, component := range components {
+		for _, n := range component {
+			indexes[n.Key()] = i
+		}
+	}
+
+	dependencies := make(map[int][]int, len(components))
+	for i, component := range components {
+		children := make(map[int]struct{})
+		dependencies[i] = make([]int, 0)
+		for _, n := range component {
+			for _, child := range n.Children() {
+				j := indexes[child.Key()]
+				if _, ok := children[j]; ok || j == i {
+					continue
+				}
+				children[j] = struct{}{}
+				dependencies[i] = append(dependencies[i], j)
+			}
+		}
+	}
+
+	condensed, err := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(dependencies))
+	if err != nil {
+		return nil, nil, err
+	}
+	return condensed, components, nil
+}
+
+func tarjan[T comparable](nodes []graph.Node[T], allowed func(T) bool) [][]graph.Node[T] {
+	index, lowLinks, onStack := make(map[T]int), make(map[T]int), make(map[T]bool)
+	s, res := make([]graph.Node[T], 0), make([][]graph.Node[T], 0)
+
+	var connect func(n graph.Node[T])
+	connect = func(n graph.Node[T]) {
+		index[n.Key()], lowLinks[n.Key()] = len(index), len(index)
+		s, onStack[n.Key()] = append(s, n), true
+
+		for _, child := range n.Children() {
+			if !allowed(child.Key()) {
+				continue
+			}
+			if _, ok := index[child.Key()]; !ok {
+				connect(child)
+				if lowLinks[child.Key()] < lowLinks[n.Key()] {
+					lowLinks[n.Key()] = lowLinks[child.Key()]
+				}
+			} else if onStack[child.Key()] && index[child.Key()] < lowLinks[n.Key()] {
+				lowLinks[n.Key()] = index[child.Key()]
+			}
+		}
+
+		if lowLinks[n.Key()] != index[n.Key()] {
+			return
+		}
+		component := make([]graph.Node[T], 0)
+		for {
+			top := s[len(s)-1]
+			s, onStack[top.Key()] = s[:len(s)-1], false
+			component = append(component, top)
+			if top.Key() == n.Key() {
+				break
+			}
+		}
+		res = append(res, component)
+	}
+
+	for _, n := range nodes {
+		if _, ok := index[n.Key()]; !ok && allowed(n.Key()) {
+			connect(n)
+		}
+	}
+	return res
+}
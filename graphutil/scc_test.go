@@ -0,0 +1,79 @@
+package graphutil_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+
+	"github.com/brmatvey/go-data-structs/slice"
+)
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	algorithms := map[string]func(graph.DirectedGraph[string]) [][]graph.Node[string]{
+		"tarjan":   graphutil.Tarjan[string],
+		"kosaraju": graphutil.Kosaraju[string],
+	}
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			actual := make([]string, 0)
+			for _, component := range algorithm(cyclicGraph()) {
+				keys := nodeKeys(component)
+				sort.Strings(keys)
+				actual = append(actual, strings.Join(keys, " "))
+			}
+			sort.Strings(actual)
+
+			if !slice.IsEqual(actual, []string{"a b c", "d e", "f"}) {
+				t.Fatalf("incorrect components %v", actual)
+			}
+		})
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	condensed, components, err := graphutil.Condensation(cyclicGraph())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(condensed.Nodes()) != 3 || len(components) != 3 {
+		t.Fatal("inconsistent components amount")
+	}
+
+	// component keys are numbered in topological order
+	for _, n := range condensed.Nodes() {
+		for _, child := range n.Children() {
+			if child.Key() <= n.Key() {
+				t.Fatal("inconsistent order")
+			}
+		}
+	}
+	for i, expected := range []string{"a b c", "d e", "f"} {
+		keys := nodeKeys(components[i])
+		sort.Strings(keys)
+		if strings.Join(keys, " ") != expected {
+			t.Fatalf("incorrect component %v", keys)
+		}
+	}
+
+	if _, err = graphutil.TopologicalSort(condensed); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func cyclicGraph() graph.DirectedGraph[string] {
+	// a -> b -> c -> a, c -> d <-> e -> f
+	dependencies := map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a", "d"},
+		"d": {"e"},
+		"e": {"d", "f"},
+		"f": {},
+	}
+	directedGraph, _ := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(dependencies))
+	return directedGraph
+}
@@ -0,0 +1,115 @@
+package graphutil
+
+import (
+	"github.com/brmatvey/go-data-structs/slice"
+	"github.com/brmatvey/go-graphs/graph"
+)
+
+// newShortestPaths doesn't visit nodes of graph, so search which stops early costs only nodes it reaches.
+func newShortestPaths[K, T comparable, W graph.Weight](start T, g graph.DirectedGraph[T]) *ShortestPaths[K, T, W] {
+	return &ShortestPaths[K, T, W]{
+		start:     start,
+		distances: map[T]W{start: 0},
+		edges:     make(map[T]graph.Edge[K, T, W]),
+		graph:     g,
+	}
+}
+
+type ShortestPaths[K, T comparable, W graph.Weight] struct {
+	start     T
+	distances map[T]W
+	edges     map[T]graph.Edge[K, T, W]
+	graph     graph.DirectedGraph[T]
+	// partial is true when search stopped before all reachable nodes were settled
+	partial bool
+}
+
+func (s *ShortestPaths[K, T, W]) Start() T { return s.start }
+
+func (s *ShortestPaths[K, T, W]) Reachable(to T) bool {
+	_, ok := s.distances[to]
+	return ok
+}
+
+func (s *ShortestPaths[K, T, W]) Distance(to T) (W, bool) {
+	d, ok := s.distances[to]
+	return d, ok
+}
+
+func (s *ShortestPaths[K, T, W]) Distances() map[T]W {
+	distances := make(map[T]W, len(s.distances))
+	for key, d := range s.distances {
+		distances[key] = d
+	}
+	return distances
+}
+
+func (s *ShortestPaths[K, T, W]) Edge(to T) (graph.Edge[K, T, W], bool) {
+	e, ok := s.edges[to]
+	return e, ok
+}
+
+// Unreachable is computed on every call from nodes of graph.
+func (s *ShortestPaths[K, T, W]) Unreachable() []T {
+	keys := make([]T, 0)
+	if s.partial {
+		return keys
+	}
+	for _, n := range s.graph.Nodes() {
+		if _, ok := s.distances[n.Key()]; !ok {
+			keys = append(keys, n.Key())
+		}
+	}
+	return keys
+}
+
+func (s *ShortestPaths[K, T, W]) PathTo(to T) ([]graph.Edge[K, T, W], W, error) {
+	if _, ok := s.graph.Node(to); !ok {
+		return nil, 0, &graph.UnknownNodeError{Key: to}
+	}
+	d, ok := s.distances[to]
+	if !ok {
+		return nil, 0, &NoPathError{From: s.start, To: to}
+	}
+	res := make([]graph.Edge[K, T, W], 0)
+	for current := to; current != s.start; {
+		e := s.edges[current]
+		res = append(res, e)
+		current = e.From().Key()
+	}
+	slice.Reverse(res)
+	return res, d, nil
+}
+
+func (s *ShortestPaths[K, T, W]) relax(e graph.Edge[K, T, W], weight W) bool {
+	from, to := e.From().Key(), e.To().Key()
+	fromDistance, ok := s.distances[from]
+	if !ok {
+		return false
+	}
+	if toDistance, ok := s.distances[to]; ok && toDistance <= add(fromDistance, weight) {
+		return false
+	}
+	s.distances[to], s.edges[to] = add(fromDistance, weight), e
+	return true
+}
+
+// keepSettled removes tentative distances of nodes which weren't settled before search stopped,
+// such nodes are neither reachable nor unreachable.
+func (s *ShortestPaths[K, T, W]) keepSettled(settled map[T]bool) {
+	for key := range s.distances {
+		if !settled[key] {
+			delete(s.distances, key)
+			delete(s.edges, key)
+		}
+	}
+	s.partial = true
+}
+
+func (s *ShortestPaths[K, T, W]) lengths() map[T]W {
+	res := s.Distances()
+	for _, key := range s.Unreachable() {
+		res[key] = graph.Infinity[W]()
+	}
+	return res
+}
@@ -0,0 +1,101 @@
+package graphutil_test
+
+import (
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestShortestPaths(t *testing.T) {
+	//   6   7    8       10
+	// 1 ->2 -> 4 -> -> 7 -> -> -> 8
+	// 1\      3    5 /    /
+	//   \->3 -> 5 ->/    /      9
+	//     2 \           / 3
+	//        \-> 6 -> ->
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 6), graph.NewLength(3, 1)},
+		2: {graph.NewLength(4, 7)},
+		3: {graph.NewLength(5, 3), graph.NewLength(6, 2)},
+		4: {graph.NewLength(7, 8)},
+		5: {graph.NewLength(7, 5)},
+		6: {graph.NewLength(8, 3)},
+		7: {graph.NewLength(8, 10)},
+		8: {},
+		9: {},
+	}
+
+	algorithms := map[string]func(int, graph.WeightedGraph[int, int, float64]) (*graphutil.ShortestPaths[int, int, float64], error){
+		"dijkstra": func(start int, g graph.WeightedGraph[int, int, float64]) (*graphutil.ShortestPaths[int, int, float64], error) {
+			return graphutil.DijkstraPaths(start, g)
+		},
+		"bellman-ford": func(start int, g graph.WeightedGraph[int, int, float64]) (*graphutil.ShortestPaths[int, int, float64], error) {
+			return graphutil.BellmanFordPaths(start, g)
+		},
+	}
+
+	for name, algorithm := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			paths, err := algorithm(1, newWeightedGraph(t, dependencies))
+			if err != nil {
+				t.Fatal("error must be nil")
+			}
+
+			edges, length, err := paths.PathTo(7)
+			if err != nil {
+				t.Fatal("error must be nil")
+			}
+			if length != 9 {
+				t.Fatalf("incorrect length %v", length)
+			}
+			checkPath(t, edges, 1, 3, 5, 7)
+
+			edges, length, err = paths.PathTo(1)
+			if err != nil {
+				t.Fatal("error must be nil")
+			}
+			if length != 0 || len(edges) != 0 {
+				t.Fatal("path to start must be empty")
+			}
+
+			if paths.Reachable(9) {
+				t.Fatal("node 9 must be unreachable")
+			}
+			if _, ok := paths.Distance(9); ok {
+				t.Fatal("distance to node 9 must not exist")
+			}
+			if unreachable := paths.Unreachable(); len(unreachable) != 1 || unreachable[0] != 9 {
+				t.Fatal("only node 9 must be unreachable")
+			}
+			if _, _, err = paths.PathTo(9); err == nil {
+				t.Fatal("error must not be nil")
+			}
+		})
+	}
+}
+
+func newWeightedGraph[T comparable, W graph.Weight](t *testing.T, dependencies map[T][]graph.Length[T, W]) graph.WeightedGraph[int, T, W] {
+	count := 0
+	edgeKeyGen := func() int {
+		count++
+		return count
+	}
+
+	weightedGraph, err := graph.NewWeightedGraphFromCreator(graph.NewWeightedGraphCreator(dependencies, edgeKeyGen))
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	return weightedGraph
+}
+
+func checkPath[T comparable, W graph.Weight](t *testing.T, edges []graph.Edge[int, T, W], keys ...T) {
+	if len(edges) != len(keys)-1 {
+		t.Fatalf("incorrect path length %d", len(edges))
+	}
+	for i, e := range edges {
+		if e.From().Key() != keys[i] || e.To().Key() != keys[i+1] {
+			t.Fatalf("incorrect edge %v -> %v", e.From().Key(), e.To().Key())
+		}
+	}
+}
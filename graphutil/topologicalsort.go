@@ -1,7 +1,8 @@
 package graphutil
 
 import (
-	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/brmatvey/go-graphs/graph"
 
@@ -9,22 +10,44 @@ import (
 	"github.com/brmatvey/go-data-structs/stack"
 )
 
+type CycleError[T comparable] struct {
+	Cycle []graph.Node[T]
+}
+
+func (e *CycleError[T]) Error() string {
+	keys := make([]string, 0, len(e.Cycle)+1)
+	for _, n := range e.Cycle {
+		keys = append(keys, fmt.Sprint(n.Key()))
+	}
+	if len(e.Cycle) > 0 {
+		keys = append(keys, fmt.Sprint(e.Cycle[0].Key()))
+	}
+	return fmt.Sprintf("cycle: %s", strings.Join(keys, " -> "))
+}
+
+func (e *CycleError[T]) Unwrap() error { return ErrCycle }
+
 func TopologicalSort[T comparable](directedGraph graph.DirectedGraph[T]) ([]graph.Node[T], error) {
-	nodes, colors := directedGraph.Nodes(), make(map[T]int)
+	type item struct {
+		node   graph.Node[T]
+		parent graph.Node[T]
+	}
+
+	nodes, colors, parents := directedGraph.Nodes(), make(map[T]int), make(map[T]graph.Node[T])
 	res := make([]graph.Node[T], 0, len(nodes))
 	for _, nod := range nodes {
-		s := stack.New[graph.Node[T]]()
-		s.Push(nod)
+		s := stack.New[item]()
+		s.Push(item{node: nod})
 		for !s.Empty() {
-			currentNode := s.Peek()
+			currentNode := s.Peek().node
 			switch colors[currentNode.Key()] {
 			case 0:
-				colors[currentNode.Key()] = 1
+				colors[currentNode.Key()], parents[currentNode.Key()] = 1, s.Peek().parent
 				for _, child := range currentNode.Children() {
 					if colors[child.Key()] == 1 {
-						return nil, errors.New("cycle")
+						return nil, newCycleError(child, currentNode, parents)
 					}
-					s.Push(child)
+					s.Push(item{node: child, parent: currentNode})
 				}
 			case 1:
 				colors[currentNode.Key()] = 2
@@ -38,3 +61,13 @@ func TopologicalSort[T comparable](directedGraph graph.DirectedGraph[T]) ([]grap
 	slice.Reverse(res)
 	return res, nil
 }
+
+func newCycleError[T comparable](first, last graph.Node[T], parents map[T]graph.Node[T]) *CycleError[T] {
+	cycle := []graph.Node[T]{last}
+	for current := last; current.Key() != first.Key(); {
+		current = parents[current.Key()]
+		cycle = append(cycle, current)
+	}
+	slice.Reverse(cycle)
+	return &CycleError[T]{Cycle: cycle}
+}
@@ -1,6 +1,7 @@
 package graphutil_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -39,6 +40,35 @@ func TestTopologicalSort(t *testing.T) {
 		}
 	})
 
+	t.Run("cycle reporting", func(t *testing.T) {
+		// a -> b -> c -> d
+		//      ^        /
+		//       \- e <-
+		dependencies := map[string][]string{
+			"a": {"b"},
+			"b": {"c"},
+			"c": {"d"},
+			"d": {"e"},
+			"e": {"b"},
+		}
+		graph, _ := graph.NewDirectedGraphFromCreator(graph.NewDirectedGraphCreator(dependencies))
+
+		_, err := graphutil.TopologicalSort(graph)
+		var cycleErr *graphutil.CycleError[string]
+		if !errors.As(err, &cycleErr) {
+			t.Fatal("error must be CycleError")
+		}
+		if len(cycleErr.Cycle) != 4 {
+			t.Fatalf("incorrect cycle %v", cycleErr)
+		}
+		for i, n := range cycleErr.Cycle {
+			next := cycleErr.Cycle[(i+1)%len(cycleErr.Cycle)]
+			if len(n.Children()) != 1 || n.Children()[0] != next {
+				t.Fatalf("incorrect cycle %v", cycleErr)
+			}
+		}
+	})
+
 	t.Run("branched struct", func(t *testing.T) {
 		graph, checker := branchedGraph()
 		sortedSeq, err := graphutil.TopologicalSort(graph)
@@ -0,0 +1,20 @@
+package graphutil
+
+import "github.com/brmatvey/go-graphs/graph"
+
+// WeightFunc selects weight of edge used by algorithm.
+type WeightFunc[K, T comparable, W graph.Weight] func(graph.Edge[K, T, W]) W
+
+func EdgeWeight[K, T comparable, W graph.Weight]() WeightFunc[K, T, W] {
+	return func(e graph.Edge[K, T, W]) W { return e.Weight() }
+}
+
+// AttributeWeight takes weight from numeric attribute of edge, edges without such attribute keep their own weight.
+func AttributeWeight[K, T comparable, W graph.Weight](name string) WeightFunc[K, T, W] {
+	return func(e graph.Edge[K, T, W]) W {
+		if w, ok := graph.Number[W](e.Attributes(), name); ok {
+			return w
+		}
+		return e.Weight()
+	}
+}
@@ -0,0 +1,45 @@
+package graphutil_test
+
+import (
+	"testing"
+
+	"github.com/brmatvey/go-graphs/graph"
+	"github.com/brmatvey/go-graphs/graphutil"
+)
+
+func TestAttributeWeight(t *testing.T) {
+	//     1         1
+	// 1 -> -> 2 -> -> 4
+	//  \  time 10    /
+	//   \-> 3 -> -> /
+	//    5    5
+	dependencies := map[int][]graph.Length[int, float64]{
+		1: {graph.NewLength(2, 1), graph.NewLength(3, 5)},
+		2: {graph.NewLength(4, 1).WithAttributes(graph.Attributes{"time": 10})},
+		3: {graph.NewLength(4, 5)},
+		4: {},
+	}
+	weightedGraph := newWeightedGraph(t, dependencies)
+	byTime := graphutil.WithWeight(graphutil.AttributeWeight[int, int, float64]("time"))
+
+	lengths, err := graphutil.Dijkstra(1, weightedGraph, byTime)
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	if lengths[4] != 10 {
+		t.Fatalf("incorrect length %v", lengths[4])
+	}
+
+	edges, length, err := graphutil.AStar(1, 4, weightedGraph, func(int) float64 { return 0 }, byTime)
+	if err != nil {
+		t.Fatal("error must be nil")
+	}
+	if length != 10 {
+		t.Fatalf("incorrect length %v", length)
+	}
+	checkPath(t, edges, 1, 3, 4)
+
+	if lengths, _ = graphutil.Dijkstra(1, weightedGraph); lengths[4] != 2 {
+		t.Fatal("edge weights must be used by default")
+	}
+}